@@ -52,6 +52,29 @@ type AuthOptions struct {
 	// TokenID allows users to authenticate (possibly as another user) with an
 	// authentication token ID.
 	TokenID string `json:"-"`
+
+	// ApplicationCredentialID and ApplicationCredentialName identify an
+	// Identity V3 application credential to authenticate with, along with its
+	// ApplicationCredentialSecret. A name also requires the user owning the
+	// credential, as UserID or as Username and DomainID or DomainName. The
+	// token is scoped to the project of the application credential.
+	ApplicationCredentialID     string `json:"-"`
+	ApplicationCredentialName   string `json:"-"`
+	ApplicationCredentialSecret string `json:"-"`
+
+	// Scope determines the scoping of the authentication request with Identity
+	// V3. When nil, it's inferred from TenantID, TenantName, DomainID and
+	// DomainName, which works when the user and the project share a domain.
+	Scope *AuthScope `json:"-"`
+}
+
+// AuthScope allows a created token to be limited to a specific domain or
+// project with Identity V3.
+type AuthScope struct {
+	ProjectID   string
+	ProjectName string
+	DomainID    string
+	DomainName  string
 }
 
 // ToTokenV2CreateMap allows AuthOptions to satisfy the AuthOptionsBuilder
@@ -102,7 +125,7 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 	type userReq struct {
 		ID       *string    `json:"id,omitempty"`
 		Name     *string    `json:"name,omitempty"`
-		Password string     `json:"password"`
+		Password *string    `json:"password,omitempty"`
 		Domain   *domainReq `json:"domain,omitempty"`
 	}
 
@@ -114,10 +137,18 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 		ID string `json:"id"`
 	}
 
+	type applicationCredentialReq struct {
+		ID     *string  `json:"id,omitempty"`
+		Name   *string  `json:"name,omitempty"`
+		User   *userReq `json:"user,omitempty"`
+		Secret *string  `json:"secret,omitempty"`
+	}
+
 	type identityReq struct {
-		Methods  []string     `json:"methods"`
-		Password *passwordReq `json:"password,omitempty"`
-		Token    *tokenReq    `json:"token,omitempty"`
+		Methods               []string                  `json:"methods"`
+		Password              *passwordReq              `json:"password,omitempty"`
+		Token                 *tokenReq                 `json:"token,omitempty"`
+		ApplicationCredential *applicationCredentialReq `json:"application_credential,omitempty"`
 	}
 
 	type authReq struct {
@@ -162,8 +193,46 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 			req.Auth.Identity.Token = &tokenReq{
 				ID: opts.TokenID,
 			}
+		} else if opts.ApplicationCredentialID != "" {
+			// Configure the request for application credential authentication by ID.
+			if opts.ApplicationCredentialSecret == "" {
+				return nil, ErrAppCredMissingSecret{}
+			}
+			req.Auth.Identity.Methods = []string{"application_credential"}
+			req.Auth.Identity.ApplicationCredential = &applicationCredentialReq{
+				ID:     &opts.ApplicationCredentialID,
+				Secret: &opts.ApplicationCredentialSecret,
+			}
+		} else if opts.ApplicationCredentialName != "" {
+			// Configure the request for application credential authentication by name, which
+			// is only unique for the user owning the credential.
+			if opts.ApplicationCredentialSecret == "" {
+				return nil, ErrAppCredMissingSecret{}
+			}
+
+			var user *userReq
+			switch {
+			case opts.UserID != "":
+				user = &userReq{ID: &opts.UserID}
+			case opts.Username == "":
+				return nil, ErrUsernameOrUserID{}
+			case opts.DomainID != "":
+				user = &userReq{Name: &opts.Username, Domain: &domainReq{ID: &opts.DomainID}}
+			case opts.DomainName != "":
+				user = &userReq{Name: &opts.Username, Domain: &domainReq{Name: &opts.DomainName}}
+			default:
+				return nil, ErrDomainIDOrDomainName{}
+			}
+
+			req.Auth.Identity.Methods = []string{"application_credential"}
+			req.Auth.Identity.ApplicationCredential = &applicationCredentialReq{
+				Name:   &opts.ApplicationCredentialName,
+				User:   user,
+				Secret: &opts.ApplicationCredentialSecret,
+			}
 		} else {
-			// If no password or token ID are available, authentication can't continue.
+			// If no password, token ID or application credential are available, authentication
+			// can't continue.
 			return nil, ErrMissingPassword{}
 		}
 	} else {
@@ -195,7 +264,7 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 				req.Auth.Identity.Password = &passwordReq{
 					User: userReq{
 						Name:     &opts.Username,
-						Password: opts.Password,
+						Password: &opts.Password,
 						Domain:   &domainReq{ID: &opts.DomainID},
 					},
 				}
@@ -206,7 +275,7 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 				req.Auth.Identity.Password = &passwordReq{
 					User: userReq{
 						Name:     &opts.Username,
-						Password: opts.Password,
+						Password: &opts.Password,
 						Domain:   &domainReq{Name: &opts.DomainName},
 					},
 				}
@@ -224,7 +293,7 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 
 			// Configure the request for UserID and Password authentication.
 			req.Auth.Identity.Password = &passwordReq{
-				User: userReq{ID: &opts.UserID, Password: opts.Password},
+				User: userReq{ID: &opts.UserID, Password: &opts.Password},
 			}
 		}
 	}
@@ -242,6 +311,11 @@ func (opts *AuthOptions) ToTokenV3CreateMap(scope map[string]interface{}) (map[s
 }
 
 func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
+	// Application credentials are already scoped to their project, and can't be
+	// scoped otherwise.
+	if opts.ApplicationCredentialID != "" || opts.ApplicationCredentialName != "" {
+		return nil, nil
+	}
 
 	var scope struct {
 		ProjectID   string
@@ -250,7 +324,12 @@ func (opts *AuthOptions) ToTokenV3ScopeMap() (map[string]interface{}, error) {
 		DomainName  string
 	}
 
-	if opts.TenantID != "" {
+	if opts.Scope != nil {
+		scope.ProjectID = opts.Scope.ProjectID
+		scope.ProjectName = opts.Scope.ProjectName
+		scope.DomainID = opts.Scope.DomainID
+		scope.DomainName = opts.Scope.DomainName
+	} else if opts.TenantID != "" {
 		scope.ProjectID = opts.TenantID
 		opts.TenantID = ""
 		opts.TenantName = ""
@@ -60,6 +60,55 @@ of results:
     return true, nil
   })
 
+The generic pagination.All and pagination.Each functions work on the items
+directly. All returns the items of every page, and Each streams them one at a
+time, holding at most two pages in memory and optionally fetching the next page
+while the current one is handled:
+
+  allServers, err := pagination.All(servers.List(client, nil), servers.ExtractServers)
+
+  opts := pagination.IteratorOpts{Prefetch: true}
+  err := pagination.Each(ports.List(client, nil), opts, ports.ExtractPorts, func(port ports.Port) (bool, error) {
+    // Handle the port.
+
+    return true, nil
+  })
+
+Some packages, like servers and ports, also provide a ListAll function that
+returns every item of a List request.
+
+Every request can be bound to a context.Context, which cancels the request,
+along with any page fetches and re-authentication it triggers, when the
+context is done. Set the Context field of a ProviderClient to apply a default
+to all of its requests, or use ServiceClient.WithContext for a single call:
+
+  ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+  defer cancel()
+
+  server, err := servers.Get(client.WithContext(ctx), "{serverId}").Extract()
+
+The resource packages have no context-taking variants of their functions:
+passing client.WithContext(ctx) is the way to bind any of them to a context.
+
+Requests failing with an unexpected HTTP status code return an error holding
+the fault reported by the service, when there's one, and the ID of the
+request. Use errors.As to get them, or errors.Is to match a kind of fault:
+
+  _, err := ports.Create(client, opts).Extract()
+
+  var fault *gophercloud.Fault
+  if errors.As(err, &fault) {
+    log.Printf("%s (request %s)", fault.Message, fault.RequestID)
+  }
+
+  if errors.Is(err, &gophercloud.Fault{Type: "IpAddressGenerationFailure"}) {
+    // The network has no IP address left.
+  }
+
+  if errors.Is(err, gophercloud.ErrQuotaExceeded) {
+    // Free some resources or ask for a bigger quota.
+  }
+
 This top-level package contains utility functions and data types that are used
 throughout the provider and service packages. Of particular note for end users
 are the AuthOptions and EndpointOpts structs.
@@ -1,6 +1,9 @@
 package gophercloud
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
+)
 
 // BaseError is an error type that all other error types embed.
 type BaseError struct {
@@ -44,14 +47,23 @@ func (e ErrInvalidInput) Error() string {
 }
 
 // ErrUnexpectedResponseCode is returned by the Request method when a response code other than
-// those listed in OkCodes is encountered.
+// those listed in OkCodes is encountered. It unwraps to its Fault, when the
+// service reported one.
 type ErrUnexpectedResponseCode struct {
 	BaseError
-	URL      string
-	Method   string
-	Expected []int
-	Actual   int
-	Body     []byte
+	URL            string
+	Method         string
+	Expected       []int
+	Actual         int
+	Body           []byte
+	ResponseHeader http.Header
+
+	// RequestID is the ID the service gave to the request, from the
+	// X-Openstack-Request-Id header of the response.
+	RequestID string
+
+	// Fault is the fault decoded from Body, or nil if it holds none.
+	Fault *Fault
 }
 
 func (e ErrUnexpectedResponseCode) Error() string {
@@ -62,6 +74,26 @@ func (e ErrUnexpectedResponseCode) Error() string {
 	return e.choseErrString()
 }
 
+// Unwrap returns the Fault reported by the service, if any.
+func (e ErrUnexpectedResponseCode) Unwrap() error {
+	if e.Fault == nil {
+		return nil
+	}
+	return e.Fault
+}
+
+// withFault appends the fault reported by the service, and the ID of the
+// request, to msg.
+func (e ErrUnexpectedResponseCode) withFault(msg string) string {
+	if e.Fault != nil && e.Fault.Message != "" {
+		msg += ": " + e.Fault.Error()
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request ID %s)", e.RequestID)
+	}
+	return msg
+}
+
 // ErrDefault400 is the default error type returned on a 400 HTTP response code.
 type ErrDefault400 struct {
 	ErrUnexpectedResponseCode
@@ -103,32 +135,44 @@ type ErrDefault503 struct {
 }
 
 func (e ErrDefault400) Error() string {
-	return "Invalid request due to incorrect syntax or missing required parameters."
+	return e.withFault("Invalid request due to incorrect syntax or missing required parameters.")
 }
 func (e ErrDefault401) Error() string {
-	return "Authentication failed"
+	return e.withFault("Authentication failed")
 }
 func (e ErrDefault404) Error() string {
-	return "Resource not found"
+	return e.withFault("Resource not found")
 }
 func (e ErrDefault405) Error() string {
-	return "Method not allowed"
+	return e.withFault("Method not allowed")
 }
 func (e ErrDefault408) Error() string {
-	return "The server timed out waiting for the request"
+	return e.withFault("The server timed out waiting for the request")
 }
 func (e ErrDefault429) Error() string {
-	return "Too many requests have been sent in a given amount of time. Pause" +
-		" requests, wait up to one minute, and try again."
+	return e.withFault("Too many requests have been sent in a given amount of time. Pause" +
+		" requests, wait up to one minute, and try again.")
 }
 func (e ErrDefault500) Error() string {
-	return "Internal Server Error"
+	return e.withFault("Internal Server Error")
 }
 func (e ErrDefault503) Error() string {
-	return "The service is currently unable to handle the request due to a temporary" +
-		" overloading or maintenance. This is a temporary condition. Try again later."
+	return e.withFault("The service is currently unable to handle the request due to a temporary" +
+		" overloading or maintenance. This is a temporary condition. Try again later.")
 }
 
+// The default error types unwrap to their ErrUnexpectedResponseCode, so that
+// errors.As finds it, and then the Fault it holds.
+
+func (e ErrDefault400) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault401) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault404) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault405) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault408) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault429) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault500) Unwrap() error { return e.ErrUnexpectedResponseCode }
+func (e ErrDefault503) Unwrap() error { return e.ErrUnexpectedResponseCode }
+
 // Err400er is the interface resource error types implement to override the error message
 // from a 400 error.
 type Err400er interface {
@@ -187,6 +231,20 @@ func (e ErrTimeOut) Error() string {
 	return e.choseErrString()
 }
 
+// ErrUnexpectedStatus is the error type returned when a resource being waited
+// for enters a status it won't leave by itself, like ERROR, instead of the one
+// expected.
+type ErrUnexpectedStatus struct {
+	BaseError
+	Expected string
+	Actual   string
+}
+
+func (e ErrUnexpectedStatus) Error() string {
+	e.DefaultErrString = fmt.Sprintf("Expected status %s, but the resource entered status %s", e.Expected, e.Actual)
+	return e.choseErrString()
+}
+
 // ErrUnableToReauthenticate is the error type returned when reauthentication fails.
 type ErrUnableToReauthenticate struct {
 	BaseError
@@ -198,6 +256,11 @@ func (e ErrUnableToReauthenticate) Error() string {
 	return e.choseErrString()
 }
 
+// Unwrap returns the original error.
+func (e ErrUnableToReauthenticate) Unwrap() error {
+	return e.ErrOriginal
+}
+
 // ErrErrorAfterReauthentication is the error type returned when reauthentication
 // succeeds, but an error occurs afterword (usually an HTTP error).
 type ErrErrorAfterReauthentication struct {
@@ -210,6 +273,11 @@ func (e ErrErrorAfterReauthentication) Error() string {
 	return e.choseErrString()
 }
 
+// Unwrap returns the original error.
+func (e ErrErrorAfterReauthentication) Unwrap() error {
+	return e.ErrOriginal
+}
+
 // ErrServiceNotFound is returned when no service in a service catalog matches
 // the provided EndpointOpts. This is generally returned by provider service
 // factory methods like "NewComputeV2()" and can mean that a service is not
@@ -372,6 +440,13 @@ func (e ErrMissingPassword) Error() string {
 	return "You must provide a password to authenticate"
 }
 
+// ErrAppCredMissingSecret indicates that an application credential was provided without its secret.
+type ErrAppCredMissingSecret struct{ BaseError }
+
+func (e ErrAppCredMissingSecret) Error() string {
+	return "You must provide the ApplicationCredentialSecret to authenticate with an application credential"
+}
+
 // ErrScopeDomainIDOrDomainName indicates that a domain ID or Name was required in a Scope, but not present.
 type ErrScopeDomainIDOrDomainName struct{ BaseError }
 
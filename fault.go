@@ -0,0 +1,210 @@
+package gophercloud
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrQuotaExceeded matches, through errors.Is, the errors of the requests
+// rejected because a quota of the project would be exceeded, whatever the
+// service:
+//
+//	if errors.Is(err, gophercloud.ErrQuotaExceeded) {
+//		// Free some resources or ask for a bigger quota.
+//	}
+var ErrQuotaExceeded = errors.New("Quota exceeded")
+
+// Fault is the error reported by an OpenStack service in the body of a failed
+// response. It's decoded from the fault formats of the main services, and
+// returned by unwrapping the error of the request:
+//
+//	var fault *gophercloud.Fault
+//	if errors.As(err, &fault) && fault.Type == "IpAddressGenerationFailure" {
+//		// The network has no IP address left.
+//	}
+//
+// A Fault may also be compared with errors.Is to a Fault holding the Code
+// and/or the Type to match:
+//
+//	if errors.Is(err, &gophercloud.Fault{Type: "OverQuota"}) {
+//		...
+//	}
+type Fault struct {
+	// Code is the HTTP status code of the fault, as reported by the service, or
+	// the one of the response otherwise.
+	Code int
+
+	// Type is the kind of fault, like "IpAddressGenerationFailure" for Neutron
+	// or "itemNotFound" for Nova.
+	Type string
+
+	// Message is the human-readable description of the fault.
+	Message string
+
+	// Details holds the additional information some services give about the
+	// fault, like a traceback.
+	Details string
+
+	// RequestID is the ID the service gave to the request, to look it up in its
+	// logs.
+	RequestID string
+}
+
+func (f *Fault) Error() string {
+	if f.Type == "" {
+		return f.Message
+	}
+	return f.Type + ": " + f.Message
+}
+
+// Is reports whether the fault matches target, which is either
+// ErrQuotaExceeded or a Fault whose non-zero Code and Type are those of the
+// fault.
+func (f *Fault) Is(target error) bool {
+	if target == ErrQuotaExceeded {
+		return f.quotaExceeded()
+	}
+
+	t, ok := target.(*Fault)
+	if !ok || t == nil || (t.Code == 0 && t.Type == "") {
+		return false
+	}
+	return (t.Code == 0 || t.Code == f.Code) && (t.Type == "" || t.Type == f.Type)
+}
+
+// quotaTypes are the types of the faults reporting an exceeded quota.
+var quotaTypes = map[string]bool{
+	"OverQuota":                       true, // Neutron
+	"QuotaError":                      true,
+	"VolumeLimitExceeded":             true, // Cinder
+	"VolumeSizeExceedsAvailableQuota": true,
+	"SnapshotLimitExceeded":           true,
+	"ShareLimitExceeded":              true, // Manila
+	"StackResourceLimitExceeded":      true, // Heat
+}
+
+func (f *Fault) quotaExceeded() bool {
+	if quotaTypes[f.Type] {
+		return true
+	}
+	// Nova and Cinder report exceeded quotas as forbidden or over limit
+	// requests, only telling them apart from the others by their message.
+	return (f.Code == http.StatusForbidden || f.Code == http.StatusRequestEntityTooLarge) &&
+		strings.Contains(strings.ToLower(f.Message), "quota")
+}
+
+// parseFault decodes the fault reported in the body of a failed response, in
+// any of the formats used by the OpenStack services. It returns nil when the
+// body holds no fault it knows of.
+func parseFault(code int, header http.Header, body []byte) *Fault {
+	f := decodeFault(body)
+	if f == nil {
+		return nil
+	}
+
+	if f.Code == 0 {
+		f.Code = code
+	}
+	f.RequestID = requestID(header)
+	return f
+}
+
+// requestID returns the ID of a request, from the headers of its response.
+func requestID(header http.Header) string {
+	if id := header.Get("X-Openstack-Request-Id"); id != "" {
+		return id
+	}
+	return header.Get("X-Compute-Request-Id")
+}
+
+func decodeFault(body []byte) *Fault {
+	var s map[string]json.RawMessage
+	if err := json.Unmarshal(body, &s); err != nil {
+		return nil
+	}
+
+	// Neutron:
+	// {"NeutronError": {"type": "...", "message": "...", "detail": "..."}}
+	if raw, ok := s["NeutronError"]; ok {
+		var e struct {
+			Type    string `json:"type"`
+			Message string `json:"message"`
+			Detail  string `json:"detail"`
+		}
+		if err := json.Unmarshal(raw, &e); err != nil {
+			// Some extensions report a message alone.
+			var message string
+			if json.Unmarshal(raw, &message) != nil {
+				return nil
+			}
+			e.Message = message
+		}
+		return &Fault{Type: e.Type, Message: e.Message, Details: e.Detail}
+	}
+
+	// Heat and Keystone:
+	// {"error": {"type": "...", "message": "...", "traceback": "..."}, "code": 400}
+	// {"error": {"code": 404, "title": "Not Found", "message": "..."}}
+	if raw, ok := s["error"]; ok {
+		var e struct {
+			Code      int    `json:"code"`
+			Type      string `json:"type"`
+			Title     string `json:"title"`
+			Message   string `json:"message"`
+			Traceback string `json:"traceback"`
+		}
+		if err := json.Unmarshal(raw, &e); err == nil && e.Message != "" {
+			f := &Fault{Code: e.Code, Type: e.Type, Message: e.Message, Details: e.Traceback}
+			if f.Type == "" {
+				f.Type = e.Title
+			}
+			if f.Code == 0 {
+				json.Unmarshal(s["code"], &f.Code)
+			}
+			return f
+		}
+	}
+
+	// Ironic wraps the faults of the other services encoded as a string:
+	// {"error_message": "{\"faultcode\": \"...\", \"faultstring\": \"...\"}"}
+	if raw, ok := s["error_message"]; ok {
+		var message string
+		if err := json.Unmarshal(raw, &message); err == nil {
+			if f := decodeFault([]byte(message)); f != nil {
+				return f
+			}
+			return &Fault{Message: message}
+		}
+	}
+
+	// Octavia and the other WSME based services:
+	// {"faultcode": "Client", "faultstring": "...", "debuginfo": null}
+	if raw, ok := s["faultstring"]; ok {
+		f := &Fault{}
+		json.Unmarshal(raw, &f.Message)
+		json.Unmarshal(s["faultcode"], &f.Type)
+		json.Unmarshal(s["debuginfo"], &f.Details)
+		return f
+	}
+
+	// Nova, Cinder and Manila, with the type of the fault as the only
+	// key:
+	// {"badRequest": {"code": 400, "message": "...", "details": "..."}}
+	if len(s) == 1 {
+		for k, raw := range s {
+			var e struct {
+				Code    int    `json:"code"`
+				Message string `json:"message"`
+				Details string `json:"details"`
+			}
+			if err := json.Unmarshal(raw, &e); err != nil || e.Message == "" {
+				return nil
+			}
+			return &Fault{Code: e.Code, Type: k, Message: e.Message, Details: e.Details}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package gophercloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// HTTPLogEntry describes a request sent, or a response received, by a
+// ProviderClient in debug mode. Credentials are redacted from its headers and
+// body.
+type HTTPLogEntry struct {
+	// Response is false for a request and true for a response.
+	Response bool
+
+	// Method and URL identify the request, also for responses.
+	Method string
+	URL    string
+
+	// StatusCode is the status code of a response.
+	StatusCode int
+
+	// Header holds the redacted headers of the request or response.
+	Header http.Header
+
+	// Body is the redacted, indented JSON body of the request or response. It's
+	// empty for bodies that aren't JSON, which are left alone so that streams,
+	// like object downloads, aren't buffered.
+	Body string
+}
+
+// Logger receives the requests and responses of a ProviderClient in debug mode.
+// Implement it to feed them to a structured logger; PrintfLogger adapts any
+// logger with a Printf method, like the standard library's.
+type Logger interface {
+	LogHTTP(entry HTTPLogEntry)
+}
+
+// PrintfLogger is a Logger writing entries as text through a Printf function.
+type PrintfLogger struct {
+	Printf func(format string, v ...interface{})
+}
+
+// LogHTTP implements the Logger interface.
+func (l PrintfLogger) LogHTTP(e HTTPLogEntry) {
+	var b bytes.Buffer
+	if e.Response {
+		b.WriteString("OpenStack Response: ")
+		b.WriteString(http.StatusText(e.StatusCode))
+		b.WriteString(" (")
+		b.WriteString(e.Method)
+		b.WriteString(" ")
+		b.WriteString(e.URL)
+		b.WriteString(")\n")
+	} else {
+		b.WriteString("OpenStack Request: ")
+		b.WriteString(e.Method)
+		b.WriteString(" ")
+		b.WriteString(e.URL)
+		b.WriteString("\n")
+	}
+
+	b.WriteString("Headers:\n")
+	e.Header.Write(&b)
+
+	if e.Body != "" {
+		b.WriteString("Body: ")
+		b.WriteString(e.Body)
+		b.WriteString("\n")
+	}
+
+	l.Printf("%s", b.String())
+}
+
+// defaultLogger writes to the standard error.
+var defaultLogger = PrintfLogger{Printf: log.New(os.Stderr, "", log.LstdFlags).Printf}
+
+// redactedHeaders lists the headers whose values are never logged.
+var redactedHeaders = []string{
+	"X-Auth-Token",
+	"X-Subject-Token",
+	"X-Service-Token",
+	"X-Auth-Key",
+	"X-Storage-Pass",
+	"X-Account-Meta-Temp-Url-Key",
+	"X-Account-Meta-Temp-Url-Key-2",
+	"X-Container-Meta-Temp-Url-Key",
+	"X-Container-Meta-Temp-Url-Key-2",
+}
+
+// redactedFields lists the JSON fields whose values are never logged, wherever
+// they appear in a body. Objects under those fields, like the "password"
+// method of identity v3 auth requests, are redacted recursively instead.
+var redactedFields = map[string]bool{
+	"password":  true,
+	"adminPass": true,
+	"secret":    true,
+}
+
+const redacted = "***"
+
+// LoggingRoundTripper is an http.RoundTripper that logs every request and
+// response going through it, with credentials redacted.
+type LoggingRoundTripper struct {
+	// Rt is the RoundTripper actually performing the requests. Defaults to
+	// http.DefaultTransport.
+	Rt http.RoundTripper
+
+	// Logger receives the requests and responses.
+	Logger Logger
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (lrt *LoggingRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	rt := lrt.Rt
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	entry := HTTPLogEntry{
+		Method: request.Method,
+		URL:    request.URL.String(),
+		Header: redactHeader(request.Header),
+	}
+
+	if request.Body != nil && isJSON(request.Header) {
+		b, err := ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		request.Body = ioutil.NopCloser(bytes.NewReader(b))
+		entry.Body = redactBody(b)
+	}
+	lrt.Logger.LogHTTP(entry)
+
+	response, err := rt.RoundTrip(request)
+	if err != nil {
+		return response, err
+	}
+
+	entry = HTTPLogEntry{
+		Response:   true,
+		Method:     request.Method,
+		URL:        request.URL.String(),
+		StatusCode: response.StatusCode,
+		Header:     redactHeader(response.Header),
+	}
+
+	if response.Body != nil && isJSON(response.Header) {
+		b, err := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		response.Body = ioutil.NopCloser(bytes.NewReader(b))
+		entry.Body = redactBody(b)
+	}
+	lrt.Logger.LogHTTP(entry)
+
+	return response, nil
+}
+
+func isJSON(h http.Header) bool {
+	return strings.HasPrefix(h.Get("Content-Type"), "application/json")
+}
+
+func redactHeader(h http.Header) http.Header {
+	r := make(http.Header, len(h))
+	for k, v := range h {
+		r[k] = v
+	}
+	for _, k := range redactedHeaders {
+		if r.Get(k) != "" {
+			r.Set(k, redacted)
+		}
+	}
+	return r
+}
+
+// redactBody returns the indented JSON body b with its credentials redacted.
+func redactBody(b []byte) string {
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return string(b)
+	}
+
+	pretty, err := json.MarshalIndent(redactValue("", v), "", "  ")
+	if err != nil {
+		return string(b)
+	}
+	return string(pretty)
+}
+
+// redactValue redacts the credentials in the JSON value v, found under key.
+func redactValue(key string, v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, sub := range t {
+			_, isObject := sub.(map[string]interface{})
+			switch {
+			case redactedFields[k] && !isObject:
+				t[k] = redacted
+			case key == "token" && k == "id":
+				// Token IDs, in identity v2 auth requests and responses.
+				t[k] = redacted
+			default:
+				t[k] = redactValue(k, sub)
+			}
+		}
+	case []interface{}:
+		for i, sub := range t {
+			t[i] = redactValue(key, sub)
+		}
+	}
+	return v
+}
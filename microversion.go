@@ -0,0 +1,110 @@
+package gophercloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// microversionServices maps service types to the service name used in the
+// OpenStack-API-Version header, when it differs from the service type.
+var microversionServices = map[string]string{
+	"volumev2": "volume",
+	"volumev3": "volume",
+	"sharev2":  "shared-file-system",
+}
+
+// legacyMicroversionHeaders maps service types to the header their releases
+// predating the OpenStack-API-Version header expect the microversion in.
+var legacyMicroversionHeaders = map[string]string{
+	"compute":  "X-OpenStack-Nova-API-Version",
+	"sharev2":  "X-OpenStack-Manila-API-Version",
+	"volume":   "X-OpenStack-Volume-API-Version",
+	"volumev2": "X-OpenStack-Volume-API-Version",
+	"volumev3": "X-OpenStack-Volume-API-Version",
+}
+
+// setMicroversionHeader adds the headers requesting the client's microversion
+// to opts, according to the client's service type. Clients without a service
+// type, like hand-built ones, keep sending the Nova header only, as they did
+// before the service type was taken into account.
+func (client *ServiceClient) setMicroversionHeader(opts *RequestOpts) {
+	if client.Microversion == "" {
+		return
+	}
+
+	if opts.MoreHeaders == nil {
+		opts.MoreHeaders = make(map[string]string)
+	}
+
+	if client.Type == "" {
+		opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+		return
+	}
+
+	service := client.Type
+	if s, ok := microversionServices[service]; ok {
+		service = s
+	}
+	opts.MoreHeaders["OpenStack-API-Version"] = service + " " + client.Microversion
+
+	if h, ok := legacyMicroversionHeaders[client.Type]; ok {
+		opts.MoreHeaders[h] = client.Microversion
+	}
+}
+
+// ParseMicroversion parses a microversion of the form "X.Y" into its major and
+// minor parts.
+func ParseMicroversion(version string) (major, minor int, err error) {
+	parts := strings.Split(version, ".")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("Invalid microversion format: %q", version)
+	}
+
+	major, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid microversion format: %q", version)
+	}
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid microversion format: %q", version)
+	}
+
+	return major, minor, nil
+}
+
+// CompareMicroversions returns -1, 0 or 1 depending on whether a is lower than,
+// equal to or greater than b.
+func CompareMicroversions(a, b string) (int, error) {
+	aMajor, aMinor, err := ParseMicroversion(a)
+	if err != nil {
+		return 0, err
+	}
+	bMajor, bMinor, err := ParseMicroversion(b)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case aMajor < bMajor, aMajor == bMajor && aMinor < bMinor:
+		return -1, nil
+	case aMajor == bMajor && aMinor == bMinor:
+		return 0, nil
+	}
+	return 1, nil
+}
+
+// MicroversionAtLeast reports whether the microversion the client requests is at
+// least version. Resource packages use it to only send or expect the fields
+// introduced by a given microversion. A client without a microversion only
+// speaks the base version of its API, so it reports false.
+func (client *ServiceClient) MicroversionAtLeast(version string) bool {
+	if client.Microversion == "" {
+		return false
+	}
+	if client.Microversion == "latest" {
+		return true
+	}
+	cmp, err := CompareMicroversions(client.Microversion, version)
+	return err == nil && cmp >= 0
+}
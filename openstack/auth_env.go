@@ -12,6 +12,9 @@ var nilOptions = gophercloud.AuthOptions{}
 // OS_* environment variables.  The following variables provide sources of truth: OS_AUTH_URL, OS_USERNAME,
 // OS_PASSWORD, OS_TENANT_ID, and OS_TENANT_NAME.  Of these, OS_USERNAME, OS_PASSWORD, and OS_AUTH_URL must
 // have settings, or an error will result.  OS_TENANT_ID and OS_TENANT_NAME are optional.
+// OS_APPLICATION_CREDENTIAL_ID, or OS_APPLICATION_CREDENTIAL_NAME along with the user, and
+// OS_APPLICATION_CREDENTIAL_SECRET authenticate with an application credential instead of a password.
+// The clientconfig package reads clouds.yaml and many more variables, like OS_REGION_NAME and OS_CACERT.
 func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 	authURL := os.Getenv("OS_AUTH_URL")
 	username := os.Getenv("OS_USERNAME")
@@ -21,18 +24,26 @@ func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 	tenantName := os.Getenv("OS_TENANT_NAME")
 	domainID := os.Getenv("OS_DOMAIN_ID")
 	domainName := os.Getenv("OS_DOMAIN_NAME")
+	applicationCredentialID := os.Getenv("OS_APPLICATION_CREDENTIAL_ID")
+	applicationCredentialName := os.Getenv("OS_APPLICATION_CREDENTIAL_NAME")
+	applicationCredentialSecret := os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET")
 
 	if authURL == "" {
 		err := gophercloud.ErrMissingInput{Argument: "authURL"}
 		return nilOptions, err
 	}
 
-	if username == "" && userID == "" {
+	if username == "" && userID == "" && applicationCredentialID == "" {
 		err := gophercloud.ErrMissingInput{Argument: "username"}
 		return nilOptions, err
 	}
 
-	if password == "" {
+	if applicationCredentialID != "" || applicationCredentialName != "" {
+		if applicationCredentialSecret == "" {
+			err := gophercloud.ErrMissingInput{Argument: "applicationCredentialSecret"}
+			return nilOptions, err
+		}
+	} else if password == "" {
 		err := gophercloud.ErrMissingInput{Argument: "password"}
 		return nilOptions, err
 	}
@@ -46,6 +57,10 @@ func AuthOptionsFromEnv() (gophercloud.AuthOptions, error) {
 		TenantName:       tenantName,
 		DomainID:         domainID,
 		DomainName:       domainName,
+
+		ApplicationCredentialID:     applicationCredentialID,
+		ApplicationCredentialName:   applicationCredentialName,
+		ApplicationCredentialSecret: applicationCredentialSecret,
 	}
 
 	return ao, nil
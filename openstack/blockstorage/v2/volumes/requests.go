@@ -115,6 +115,11 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 	})
 }
 
+// ListAll returns the volumes matching opts from every page of a List request.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Volume, error) {
+	return pagination.All(List(client, opts), ExtractVolumes)
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -201,3 +201,21 @@ func MockUpdateResponse(t *testing.T) {
         `)
 	})
 }
+
+// MockGetStatusesResponse serves a volume whose status is the next one of
+// statuses on each Get, staying at the last one.
+func MockGetStatusesResponse(t *testing.T, statuses ...string) {
+	th.Mux.HandleFunc("/volumes/d32019d3-bc6e-4319-9c1d-6722fc136a22", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"volume": {"id": "d32019d3-bc6e-4319-9c1d-6722fc136a22", "status": "%s"}}`, status)
+	})
+}
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -164,6 +165,9 @@ func TestListAll(t *testing.T) {
 
 	th.CheckDeepEquals(t, expected, actual)
 
+	typed, err := volumes.ListAll(client.ServiceClient(), &volumes.ListOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, typed)
 }
 
 func TestGet(t *testing.T) {
@@ -214,3 +218,42 @@ func TestUpdate(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, "vol-002", v.Name)
 }
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetStatusesResponse(t, "creating", "creating", "available")
+
+	err := volumes.WaitForStatusContext(context.Background(), client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", "available", gophercloud.WaitOpts{
+		Timeout:  5 * time.Second,
+		Interval: time.Millisecond,
+	})
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStatusFailsOnError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetStatusesResponse(t, "creating", "error")
+
+	err := volumes.WaitForStatusContext(context.Background(), client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", "available", gophercloud.WaitOpts{
+		Timeout:  5 * time.Second,
+		Interval: time.Millisecond,
+	})
+	th.AssertEquals(t, gophercloud.ErrUnexpectedStatus{Expected: "available", Actual: "error"}, err)
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetStatusesResponse(t, "creating")
+
+	err := volumes.WaitForStatusContext(context.Background(), client.ServiceClient(), "d32019d3-bc6e-4319-9c1d-6722fc136a22", "available", gophercloud.WaitOpts{
+		Timeout:  50 * time.Millisecond,
+		Interval: time.Millisecond,
+	})
+	th.AssertEquals(t, gophercloud.ErrTimeOut{}, err)
+}
@@ -1,9 +1,13 @@
 package openstack
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"os"
 	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	tokens2 "github.com/gophercloud/gophercloud/openstack/identity/v2/tokens"
@@ -20,6 +24,7 @@ const (
 // Most users will probably prefer using the AuthenticatedClient function instead.
 // This is useful if you wish to explicitly control the version of the identity service that's used for authentication explicitly,
 // for example.
+// Setting the OS_DEBUG environment variable to a true value, like "1", enables the client's debug logging.
 func NewClient(endpoint string) (*gophercloud.ProviderClient, error) {
 	u, err := url.Parse(endpoint)
 	if err != nil {
@@ -32,17 +37,17 @@ func NewClient(endpoint string) (*gophercloud.ProviderClient, error) {
 	endpoint = gophercloud.NormalizeURL(endpoint)
 	base = gophercloud.NormalizeURL(base)
 
+	p := &gophercloud.ProviderClient{
+		IdentityBase:     base,
+		IdentityEndpoint: "",
+	}
 	if hadPath {
-		return &gophercloud.ProviderClient{
-			IdentityBase:     base,
-			IdentityEndpoint: endpoint,
-		}, nil
+		p.IdentityEndpoint = endpoint
 	}
+	p.UseTokenLock()
+	p.Debug, _ = strconv.ParseBool(os.Getenv("OS_DEBUG"))
 
-	return &gophercloud.ProviderClient{
-		IdentityBase:     base,
-		IdentityEndpoint: "",
-	}, nil
+	return p, nil
 }
 
 // AuthenticatedClient logs in to an OpenStack cloud found at the identity endpoint specified by options, acquires a token, and
@@ -76,9 +81,9 @@ func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOp
 
 	switch chosen.ID {
 	case v20:
-		return v2auth(client, endpoint, options, gophercloud.EndpointOpts{})
+		return v2auth(client.Context, client, endpoint, options, gophercloud.EndpointOpts{})
 	case v30:
-		return v3auth(client, endpoint, &options, gophercloud.EndpointOpts{})
+		return v3auth(client.Context, client, endpoint, &options, gophercloud.EndpointOpts{})
 	default:
 		// The switch statement must be out of date from the versions list.
 		return fmt.Errorf("Unrecognized identity version: %s", chosen.ID)
@@ -87,18 +92,45 @@ func Authenticate(client *gophercloud.ProviderClient, options gophercloud.AuthOp
 
 // AuthenticateV2 explicitly authenticates against the identity v2 endpoint.
 func AuthenticateV2(client *gophercloud.ProviderClient, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
-	return v2auth(client, "", options, eo)
+	return v2auth(client.Context, client, "", options, eo)
 }
 
-func v2auth(client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
-	v2Client, err := NewIdentityV2(client, eo)
+func v2auth(ctx context.Context, client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
+	result, err := v2token(ctx, client, endpoint, options, eo)
 	if err != nil {
 		return err
 	}
 
+	if options.AllowReauth {
+		client.ReauthFunc = func() error {
+			return v2reauth(client.Context, client, endpoint, options, eo)
+		}
+		client.ReauthContextFunc = func(ctx context.Context) error {
+			return v2reauth(ctx, client, endpoint, options, eo)
+		}
+	}
+
+	return v2apply(client, result)
+}
+
+func v2reauth(ctx context.Context, client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) error {
+	result, err := v2token(ctx, client, endpoint, options, eo)
+	if err != nil {
+		return err
+	}
+	return v2apply(client, result)
+}
+
+func v2token(ctx context.Context, client *gophercloud.ProviderClient, endpoint string, options gophercloud.AuthOptions, eo gophercloud.EndpointOpts) (tokens2.CreateResult, error) {
+	v2Client, err := NewIdentityV2(throwawayClient(client), eo)
+	if err != nil {
+		return tokens2.CreateResult{}, err
+	}
+
 	if endpoint != "" {
 		v2Client.Endpoint = endpoint
 	}
+	v2Client.Context = ctx
 
 	v2Opts := tokens2.AuthOptions{
 		IdentityEndpoint: options.IdentityEndpoint,
@@ -110,8 +142,10 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		TokenID:          options.TokenID,
 	}
 
-	result := tokens2.Create(v2Client, v2Opts)
+	return tokens2.Create(v2Client, v2Opts), nil
+}
 
+func v2apply(client *gophercloud.ProviderClient, result tokens2.CreateResult) error {
 	token, err := result.ExtractToken()
 	if err != nil {
 		return err
@@ -122,13 +156,7 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 		return err
 	}
 
-	if options.AllowReauth {
-		client.ReauthFunc = func() error {
-			client.TokenID = ""
-			return v2auth(client, endpoint, options, eo)
-		}
-	}
-	client.TokenID = token.ID
+	client.SetTokenAndExpiry(token.ID, token.ExpiresAt)
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return V2EndpointURL(catalog, opts)
 	}
@@ -138,22 +166,51 @@ func v2auth(client *gophercloud.ProviderClient, endpoint string, options gopherc
 
 // AuthenticateV3 explicitly authenticates against the identity v3 service.
 func AuthenticateV3(client *gophercloud.ProviderClient, options tokens3.AuthOptionsBuilder, eo gophercloud.EndpointOpts) error {
-	return v3auth(client, "", options, eo)
+	return v3auth(client.Context, client, "", options, eo)
 }
 
-func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.AuthOptionsBuilder, eo gophercloud.EndpointOpts) error {
-	// Override the generated service endpoint with the one returned by the version endpoint.
-	v3Client, err := NewIdentityV3(client, eo)
+func v3auth(ctx context.Context, client *gophercloud.ProviderClient, endpoint string, opts tokens3.AuthOptionsBuilder, eo gophercloud.EndpointOpts) error {
+	result, err := v3token(ctx, client, endpoint, opts, eo)
+	if err != nil {
+		return err
+	}
+
+	if opts.CanReauth() {
+		client.ReauthFunc = func() error {
+			return v3reauth(client.Context, client, endpoint, opts, eo)
+		}
+		client.ReauthContextFunc = func(ctx context.Context) error {
+			return v3reauth(ctx, client, endpoint, opts, eo)
+		}
+	}
+
+	return v3apply(client, result)
+}
+
+func v3reauth(ctx context.Context, client *gophercloud.ProviderClient, endpoint string, opts tokens3.AuthOptionsBuilder, eo gophercloud.EndpointOpts) error {
+	result, err := v3token(ctx, client, endpoint, opts, eo)
 	if err != nil {
 		return err
 	}
+	return v3apply(client, result)
+}
+
+func v3token(ctx context.Context, client *gophercloud.ProviderClient, endpoint string, opts tokens3.AuthOptionsBuilder, eo gophercloud.EndpointOpts) (tokens3.CreateResult, error) {
+	// Override the generated service endpoint with the one returned by the version endpoint.
+	v3Client, err := NewIdentityV3(throwawayClient(client), eo)
+	if err != nil {
+		return tokens3.CreateResult{}, err
+	}
 
 	if endpoint != "" {
 		v3Client.Endpoint = endpoint
 	}
+	v3Client.Context = ctx
 
-	result := tokens3.Create(v3Client, opts)
+	return tokens3.Create(v3Client, opts), nil
+}
 
+func v3apply(client *gophercloud.ProviderClient, result tokens3.CreateResult) error {
 	token, err := result.ExtractToken()
 	if err != nil {
 		return err
@@ -164,14 +221,7 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.Au
 		return err
 	}
 
-	client.TokenID = token.ID
-
-	if opts.CanReauth() {
-		client.ReauthFunc = func() error {
-			client.TokenID = ""
-			return v3auth(client, endpoint, opts, eo)
-		}
-	}
+	client.SetTokenAndExpiry(token.ID, time.Time(token.ExpiresAt))
 	client.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
 		return V3EndpointURL(catalog, opts)
 	}
@@ -179,11 +229,24 @@ func v3auth(client *gophercloud.ProviderClient, endpoint string, opts tokens3.Au
 	return nil
 }
 
+// throwawayClient returns a copy of client to request tokens with. It carries no
+// token, so that the current one isn't sent along and keeps being used by
+// concurrent requests until the new one is issued, and it can't re-authenticate,
+// so that a rejected token request doesn't recurse into another one.
+func throwawayClient(client *gophercloud.ProviderClient) *gophercloud.ProviderClient {
+	tac := client.Copy()
+	tac.TokenID = ""
+	tac.ReauthFunc = nil
+	tac.ReauthContextFunc = nil
+	return tac
+}
+
 // NewIdentityV2 creates a ServiceClient that may be used to interact with the v2 identity service.
 func NewIdentityV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	endpoint := client.IdentityBase + "v2.0/"
 	var err error
-	if !reflect.DeepEqual(eo, gophercloud.EndpointOpts{}) {
+	// The endpoint can only be looked up in the catalog once authenticated.
+	if !reflect.DeepEqual(eo, gophercloud.EndpointOpts{}) && client.EndpointLocator != nil {
 		eo.ApplyDefaults("identity")
 		endpoint, err = client.EndpointLocator(eo)
 		if err != nil {
@@ -194,6 +257,7 @@ func NewIdentityV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       endpoint,
+		Type:           "identity",
 	}, nil
 }
 
@@ -201,7 +265,8 @@ func NewIdentityV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
 	endpoint := client.IdentityBase + "v3/"
 	var err error
-	if !reflect.DeepEqual(eo, gophercloud.EndpointOpts{}) {
+	// The endpoint can only be looked up in the catalog once authenticated.
+	if !reflect.DeepEqual(eo, gophercloud.EndpointOpts{}) && client.EndpointLocator != nil {
 		eo.ApplyDefaults("identity")
 		endpoint, err = client.EndpointLocator(eo)
 		if err != nil {
@@ -212,6 +277,7 @@ func NewIdentityV3(client *gophercloud.ProviderClient, eo gophercloud.EndpointOp
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       endpoint,
+		Type:           "identity",
 	}, nil
 }
 
@@ -222,7 +288,7 @@ func NewObjectStorageV1(client *gophercloud.ProviderClient, eo gophercloud.Endpo
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "object-store"}, nil
 }
 
 // NewComputeV2 creates a ServiceClient that may be used with the v2 compute package.
@@ -232,7 +298,7 @@ func NewComputeV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpt
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "compute"}, nil
 }
 
 // NewNetworkV2 creates a ServiceClient that may be used with the v2 network package.
@@ -245,6 +311,7 @@ func NewNetworkV2(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpt
 	return &gophercloud.ServiceClient{
 		ProviderClient: client,
 		Endpoint:       url,
+		Type:           "network",
 		ResourceBase:   url + "v2.0/",
 	}, nil
 }
@@ -256,7 +323,7 @@ func NewBlockStorageV1(client *gophercloud.ProviderClient, eo gophercloud.Endpoi
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "volume"}, nil
 }
 
 // NewBlockStorageV2 creates a ServiceClient that may be used to access the v2 block storage service.
@@ -266,7 +333,7 @@ func NewBlockStorageV2(client *gophercloud.ProviderClient, eo gophercloud.Endpoi
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "volumev2"}, nil
 }
 
 // NewSharedFileSystemV2 creates a ServiceClient that may be used to access the v2 shared file system service.
@@ -276,7 +343,7 @@ func NewSharedFileSystemV2(client *gophercloud.ProviderClient, eo gophercloud.En
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "sharev2"}, nil
 }
 
 // NewCDNV1 creates a ServiceClient that may be used to access the OpenStack v1
@@ -287,7 +354,7 @@ func NewCDNV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "cdn"}, nil
 }
 
 // NewOrchestrationV1 creates a ServiceClient that may be used to access the v1 orchestration service.
@@ -297,7 +364,7 @@ func NewOrchestrationV1(client *gophercloud.ProviderClient, eo gophercloud.Endpo
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "orchestration"}, nil
 }
 
 // NewDBV1 creates a ServiceClient that may be used to access the v1 DB service.
@@ -307,5 +374,5 @@ func NewDBV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*
 	if err != nil {
 		return nil, err
 	}
-	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url}, nil
+	return &gophercloud.ServiceClient{ProviderClient: client, Endpoint: url, Type: "database"}, nil
 }
@@ -0,0 +1,217 @@
+package clientconfig
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Cloud holds the settings of a cloud, as found in clouds.yaml.
+type Cloud struct {
+	// Profile is the name of the clouds-public.yaml entry the cloud inherits
+	// its settings from.
+	Profile string `yaml:"profile"`
+
+	// AuthType is the authentication method: "password" (the default),
+	// "token" or "applicationcredential", optionally prefixed with the
+	// identity version, like "v3password".
+	AuthType string `yaml:"auth_type"`
+
+	// AuthInfo holds the credentials and the identity endpoint.
+	AuthInfo AuthInfo `yaml:"auth"`
+
+	// RegionName is the region the service endpoints are looked up in.
+	RegionName string `yaml:"region_name"`
+
+	// Interface is the interface of the service endpoints: "public",
+	// "internal" or "admin". EndpointType is its legacy name.
+	Interface    string `yaml:"interface"`
+	EndpointType string `yaml:"endpoint_type"`
+
+	// IdentityAPIVersion forces the identity version, "2" or "3". It's
+	// otherwise discovered from the identity endpoint.
+	IdentityAPIVersion string `yaml:"identity_api_version"`
+
+	// Verify is false when server certificates aren't verified.
+	Verify *bool `yaml:"verify"`
+
+	// CACertFile, ClientCertFile and ClientKeyFile are the paths to the
+	// certificate authorities and to the client certificate and key.
+	CACertFile     string `yaml:"cacert"`
+	ClientCertFile string `yaml:"cert"`
+	ClientKeyFile  string `yaml:"key"`
+}
+
+// AuthInfo holds the authentication settings of a cloud.
+type AuthInfo struct {
+	AuthURL  string `yaml:"auth_url"`
+	Token    string `yaml:"token"`
+	Username string `yaml:"username"`
+	UserID   string `yaml:"user_id"`
+	Password string `yaml:"password"`
+
+	ProjectName string `yaml:"project_name"`
+	ProjectID   string `yaml:"project_id"`
+
+	UserDomainName    string `yaml:"user_domain_name"`
+	UserDomainID      string `yaml:"user_domain_id"`
+	ProjectDomainName string `yaml:"project_domain_name"`
+	ProjectDomainID   string `yaml:"project_domain_id"`
+
+	// DomainName and DomainID scope the token to a domain when no project is
+	// given, and are the default domain of the user and the project otherwise.
+	DomainName string `yaml:"domain_name"`
+	DomainID   string `yaml:"domain_id"`
+
+	// DefaultDomain is the ID of the domain used for the user and the project
+	// when none of the above is given.
+	DefaultDomain string `yaml:"default_domain"`
+
+	ApplicationCredentialID     string `yaml:"application_credential_id"`
+	ApplicationCredentialName   string `yaml:"application_credential_name"`
+	ApplicationCredentialSecret string `yaml:"application_credential_secret"`
+}
+
+// AuthOptions returns the options to authenticate to the cloud with.
+func (c *Cloud) AuthOptions() (gophercloud.AuthOptions, error) {
+	a := c.AuthInfo
+	if a.AuthURL == "" {
+		return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "auth_url"}
+	}
+
+	ao := gophercloud.AuthOptions{
+		IdentityEndpoint: a.AuthURL,
+		AllowReauth:      true,
+	}
+
+	authType := strings.TrimPrefix(strings.TrimPrefix(c.AuthType, "v2"), "v3")
+	if authType == "" && a.Password == "" {
+		switch {
+		case a.Token != "":
+			authType = "token"
+		case a.ApplicationCredentialID != "" || a.ApplicationCredentialName != "":
+			authType = "applicationcredential"
+		}
+	}
+
+	switch authType {
+	case "", "password":
+		if a.Username == "" && a.UserID == "" {
+			return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "username"}
+		}
+		if a.Password == "" {
+			return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "password"}
+		}
+		ao.Username = a.Username
+		ao.UserID = a.UserID
+		ao.Password = a.Password
+	case "token":
+		if a.Token == "" {
+			return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "token"}
+		}
+		ao.TokenID = a.Token
+	case "applicationcredential":
+		if a.ApplicationCredentialID == "" && a.ApplicationCredentialName == "" {
+			return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "application_credential_id"}
+		}
+		if a.ApplicationCredentialSecret == "" {
+			return gophercloud.AuthOptions{}, gophercloud.ErrMissingInput{Argument: "application_credential_secret"}
+		}
+		ao.ApplicationCredentialID = a.ApplicationCredentialID
+		ao.ApplicationCredentialSecret = a.ApplicationCredentialSecret
+		if ao.ApplicationCredentialID == "" {
+			// A name is only unique for the user owning the credential.
+			ao.ApplicationCredentialName = a.ApplicationCredentialName
+			ao.Username = a.Username
+			ao.UserID = a.UserID
+		}
+	default:
+		return gophercloud.AuthOptions{}, fmt.Errorf("Unsupported auth type: %s", c.AuthType)
+	}
+
+	if c.isIdentityV2() {
+		ao.TenantID = a.ProjectID
+		ao.TenantName = a.ProjectName
+		return ao, nil
+	}
+
+	// Identity V3 only accepts a user domain along with a user name.
+	if ao.Username != "" {
+		ao.DomainID, ao.DomainName = firstDomain(
+			a.UserDomainID, a.UserDomainName, a.DomainID, a.DomainName, a.DefaultDomain)
+	}
+
+	// Application credentials are already scoped to their project.
+	if ao.ApplicationCredentialID != "" || ao.ApplicationCredentialName != "" {
+		return ao, nil
+	}
+
+	switch {
+	case a.ProjectID != "":
+		ao.Scope = &gophercloud.AuthScope{ProjectID: a.ProjectID}
+	case a.ProjectName != "":
+		ao.Scope = &gophercloud.AuthScope{ProjectName: a.ProjectName}
+		ao.Scope.DomainID, ao.Scope.DomainName = firstDomain(
+			a.ProjectDomainID, a.ProjectDomainName, a.DomainID, a.DomainName, a.DefaultDomain)
+	case a.DomainID != "" || a.DomainName != "":
+		ao.Scope = &gophercloud.AuthScope{}
+		ao.Scope.DomainID, ao.Scope.DomainName = firstDomain(a.DomainID, a.DomainName, "", "", "")
+	}
+
+	return ao, nil
+}
+
+// EndpointOpts returns the options to locate the endpoints of the cloud's
+// services with. Their Type is left to the service client constructors.
+func (c *Cloud) EndpointOpts() gophercloud.EndpointOpts {
+	iface := c.Interface
+	if iface == "" {
+		iface = c.EndpointType
+	}
+
+	return gophercloud.EndpointOpts{
+		Region: c.RegionName,
+		// The legacy names of the interfaces are suffixed with "URL", like
+		// "publicURL".
+		Availability: gophercloud.Availability(strings.TrimSuffix(iface, "URL")),
+	}
+}
+
+// TransportOpts returns the TLS settings of the cloud, to build its HTTP
+// client with gophercloud.NewHTTPClient.
+func (c *Cloud) TransportOpts() gophercloud.TransportOpts {
+	return gophercloud.TransportOpts{
+		CACertFile:     c.CACertFile,
+		ClientCertFile: c.ClientCertFile,
+		ClientKeyFile:  c.ClientKeyFile,
+		Insecure:       c.Verify != nil && !*c.Verify,
+	}
+}
+
+// isIdentityV2 tells whether the cloud pins the identity version to 2.
+func (c *Cloud) isIdentityV2() bool {
+	return strings.HasPrefix(c.AuthType, "v2") || strings.HasPrefix(c.IdentityAPIVersion, "2")
+}
+
+// isIdentityV3 tells whether the cloud pins the identity version to 3.
+func (c *Cloud) isIdentityV3() bool {
+	return !c.isIdentityV2() &&
+		(strings.HasPrefix(c.AuthType, "v3") || strings.HasPrefix(c.IdentityAPIVersion, "3"))
+}
+
+// firstDomain returns the first domain given, as an (ID, name) pair. Each
+// pair of arguments is an ID and a name, and defaultID is a last resort ID.
+func firstDomain(id1, name1, id2, name2, defaultID string) (string, string) {
+	switch {
+	case id1 != "":
+		return id1, ""
+	case name1 != "":
+		return "", name1
+	case id2 != "":
+		return id2, ""
+	case name2 != "":
+		return "", name2
+	}
+	return defaultID, ""
+}
@@ -0,0 +1,267 @@
+package clientconfig
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"gopkg.in/yaml.v2"
+)
+
+// ClientOpts selects the cloud to read the settings of.
+type ClientOpts struct {
+	// Cloud is the name of the cloud in clouds.yaml. Defaults to the OS_CLOUD
+	// environment variable. When neither is set, the settings come from the
+	// environment variables alone.
+	Cloud string
+}
+
+// configDirs returns the directories the configuration files are searched in.
+func configDirs() []string {
+	dirs := []string{"."}
+
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			configHome = filepath.Join(home, ".config")
+		}
+	}
+	if configHome != "" {
+		dirs = append(dirs, filepath.Join(configHome, "openstack"))
+	}
+
+	return append(dirs, "/etc/openstack")
+}
+
+// loadFile reads the entries under key in the YAML file name, from envVar if
+// set or else the first configuration directory holding the file. It returns
+// nil when the file isn't found.
+func loadFile(name, envVar, key string) (map[interface{}]interface{}, error) {
+	var paths []string
+	if path := os.Getenv(envVar); envVar != "" && path != "" {
+		paths = []string{path}
+	} else {
+		for _, dir := range configDirs() {
+			paths = append(paths, filepath.Join(dir, name))
+		}
+	}
+
+	for _, path := range paths {
+		b, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var content map[interface{}]interface{}
+		if err := yaml.Unmarshal(b, &content); err != nil {
+			return nil, fmt.Errorf("Unable to parse %s: %s", path, err)
+		}
+
+		entries, _ := content[key].(map[interface{}]interface{})
+		if entries == nil {
+			entries = map[interface{}]interface{}{}
+		}
+		return entries, nil
+	}
+
+	return nil, nil
+}
+
+// mergeSettings returns the settings of base overridden by those of override,
+// merging their nested settings.
+func mergeSettings(base, override map[interface{}]interface{}) map[interface{}]interface{} {
+	merged := make(map[interface{}]interface{}, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range override {
+		baseMap, baseIsMap := merged[k].(map[interface{}]interface{})
+		overrideMap, overrideIsMap := v.(map[interface{}]interface{})
+		if baseIsMap && overrideIsMap {
+			merged[k] = mergeSettings(baseMap, overrideMap)
+		} else {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+// GetCloud returns the settings of the cloud selected by opts, merged from the
+// configuration files and the environment variables.
+func GetCloud(opts *ClientOpts) (*Cloud, error) {
+	name := os.Getenv("OS_CLOUD")
+	if opts != nil && opts.Cloud != "" {
+		name = opts.Cloud
+	}
+
+	cloud := &Cloud{}
+	if name != "" {
+		settings, err := cloudSettings(name)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := yaml.Marshal(settings)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(b, cloud); err != nil {
+			return nil, fmt.Errorf("Unable to parse the settings of cloud %s: %s", name, err)
+		}
+	}
+
+	applyEnv(cloud)
+
+	return cloud, nil
+}
+
+// cloudSettings merges the settings of the cloud name from clouds-public.yaml,
+// clouds.yaml and secure.yaml.
+func cloudSettings(name string) (map[interface{}]interface{}, error) {
+	clouds, err := loadFile("clouds.yaml", "OS_CLIENT_CONFIG_FILE", "clouds")
+	if err != nil {
+		return nil, err
+	}
+	if clouds == nil {
+		return nil, fmt.Errorf("Unable to find clouds.yaml to read cloud %s from", name)
+	}
+
+	settings, ok := clouds[name].(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Cloud %s not found in clouds.yaml", name)
+	}
+
+	secure, err := loadFile("secure.yaml", "OS_CLIENT_SECURE_FILE", "clouds")
+	if err != nil {
+		return nil, err
+	}
+	if secureSettings, ok := secure[name].(map[interface{}]interface{}); ok {
+		settings = mergeSettings(settings, secureSettings)
+	}
+
+	// "cloud" is the legacy name of "profile".
+	profile, _ := settings["profile"].(string)
+	if profile == "" {
+		profile, _ = settings["cloud"].(string)
+	}
+	if profile == "" {
+		return settings, nil
+	}
+
+	public, err := loadFile("clouds-public.yaml", "", "public-clouds")
+	if err != nil {
+		return nil, err
+	}
+	publicSettings, ok := public[profile].(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("Profile %s of cloud %s not found in clouds-public.yaml", profile, name)
+	}
+
+	return mergeSettings(publicSettings, settings), nil
+}
+
+// envSetting is a setting overridden by environment variables. Where several
+// variables override it, the first one set wins.
+type envSetting struct {
+	vars    []string
+	setting *string
+}
+
+func envSettings(c *Cloud) []envSetting {
+	a := &c.AuthInfo
+	return []envSetting{
+		{[]string{"OS_AUTH_TYPE"}, &c.AuthType},
+		{[]string{"OS_AUTH_URL"}, &a.AuthURL},
+		{[]string{"OS_TOKEN"}, &a.Token},
+		{[]string{"OS_USERNAME"}, &a.Username},
+		{[]string{"OS_USER_ID", "OS_USERID"}, &a.UserID},
+		{[]string{"OS_PASSWORD"}, &a.Password},
+		{[]string{"OS_PROJECT_NAME", "OS_TENANT_NAME"}, &a.ProjectName},
+		{[]string{"OS_PROJECT_ID", "OS_TENANT_ID"}, &a.ProjectID},
+		{[]string{"OS_USER_DOMAIN_NAME"}, &a.UserDomainName},
+		{[]string{"OS_USER_DOMAIN_ID"}, &a.UserDomainID},
+		{[]string{"OS_PROJECT_DOMAIN_NAME"}, &a.ProjectDomainName},
+		{[]string{"OS_PROJECT_DOMAIN_ID"}, &a.ProjectDomainID},
+		{[]string{"OS_DOMAIN_NAME"}, &a.DomainName},
+		{[]string{"OS_DOMAIN_ID"}, &a.DomainID},
+		{[]string{"OS_DEFAULT_DOMAIN"}, &a.DefaultDomain},
+		{[]string{"OS_APPLICATION_CREDENTIAL_ID"}, &a.ApplicationCredentialID},
+		{[]string{"OS_APPLICATION_CREDENTIAL_NAME"}, &a.ApplicationCredentialName},
+		{[]string{"OS_APPLICATION_CREDENTIAL_SECRET"}, &a.ApplicationCredentialSecret},
+		{[]string{"OS_REGION_NAME"}, &c.RegionName},
+		{[]string{"OS_INTERFACE", "OS_ENDPOINT_TYPE"}, &c.Interface},
+		{[]string{"OS_IDENTITY_API_VERSION"}, &c.IdentityAPIVersion},
+		{[]string{"OS_CACERT"}, &c.CACertFile},
+		{[]string{"OS_CERT"}, &c.ClientCertFile},
+		{[]string{"OS_KEY"}, &c.ClientKeyFile},
+	}
+}
+
+// applyEnv overrides the settings of c with the OS_* environment variables
+// that are set.
+func applyEnv(c *Cloud) {
+	for _, s := range envSettings(c) {
+		for _, v := range s.vars {
+			if value := os.Getenv(v); value != "" {
+				*s.setting = value
+				break
+			}
+		}
+	}
+
+	if insecure, err := strconv.ParseBool(os.Getenv("OS_INSECURE")); err == nil {
+		verify := !insecure
+		c.Verify = &verify
+	}
+}
+
+// AuthenticatedClient authenticates to the cloud selected by opts and returns
+// a ProviderClient ready to operate, using the cloud's TLS settings. Its
+// services are found with the options returned by the cloud's EndpointOpts.
+// The identity version is the one the cloud pins, with identity_api_version
+// or a versioned auth_type, and the most recent one available otherwise.
+func AuthenticatedClient(opts *ClientOpts) (*gophercloud.ProviderClient, error) {
+	cloud, err := GetCloud(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ao, err := cloud.AuthOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient, err := gophercloud.NewHTTPClient(cloud.TransportOpts())
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := openstack.NewClient(ao.IdentityEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	client.HTTPClient = httpClient
+
+	// A pinned identity version is used as is, since the options are shaped for
+	// it. The most recent version offered is picked otherwise.
+	switch {
+	case cloud.isIdentityV2():
+		err = openstack.AuthenticateV2(client, ao, cloud.EndpointOpts())
+	case cloud.isIdentityV3():
+		err = openstack.AuthenticateV3(client, &ao, cloud.EndpointOpts())
+	default:
+		err = openstack.Authenticate(client, ao)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
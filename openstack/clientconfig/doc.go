@@ -0,0 +1,54 @@
+/*
+Package clientconfig reads the clouds.yaml configuration files shared by the
+OpenStack clients, and turns the cloud they describe into the options needed to
+build an authenticated ProviderClient.
+
+The clouds.yaml, secure.yaml and clouds-public.yaml files are searched for in
+the current directory, in $XDG_CONFIG_HOME/openstack (~/.config/openstack by
+default) and in /etc/openstack, and the first one found of each is used. The
+OS_CLIENT_CONFIG_FILE and OS_CLIENT_SECURE_FILE environment variables point to
+a specific clouds.yaml and secure.yaml.
+
+The settings of a cloud are merged from, in increasing order of precedence, the
+profile it names in clouds-public.yaml, its entry in clouds.yaml, its entry in
+secure.yaml and the OS_* environment variables. The cloud is selected by the
+Cloud field of ClientOpts or by the OS_CLOUD environment variable. When no cloud
+is selected, the settings come from the environment variables alone.
+
+Example to Create an Authenticated Client from clouds.yaml
+
+	opts := &clientconfig.ClientOpts{Cloud: "mycloud"}
+
+	provider, err := clientconfig.AuthenticatedClient(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	cloud, err := clientconfig.GetCloud(opts)
+	if err != nil {
+		panic(err)
+	}
+
+	client, err := openstack.NewComputeV2(provider, cloud.EndpointOpts())
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Options of a Cloud
+
+	cloud, err := clientconfig.GetCloud(&clientconfig.ClientOpts{Cloud: "mycloud"})
+	if err != nil {
+		panic(err)
+	}
+
+	authOpts, err := cloud.AuthOptions()
+	if err != nil {
+		panic(err)
+	}
+
+	httpClient, err := gophercloud.NewHTTPClient(cloud.TransportOpts())
+	if err != nil {
+		panic(err)
+	}
+*/
+package clientconfig
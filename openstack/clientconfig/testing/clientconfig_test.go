@@ -0,0 +1,311 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/clientconfig"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+var allFiles = map[string]string{
+	"clouds.yaml":        CloudsYAML,
+	"secure.yaml":        SecureYAML,
+	"clouds-public.yaml": PublicCloudsYAML,
+}
+
+func TestGetCloudMergesFiles(t *testing.T) {
+	defer SetupConfig(t, allFiles)()
+
+	cloud, err := clientconfig.GetCloud(&clientconfig.ClientOpts{Cloud: "mycloud"})
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, "https://example.com/identity/v3", cloud.AuthInfo.AuthURL)
+	th.CheckEquals(t, "me", cloud.AuthInfo.Username)
+	th.CheckEquals(t, "secret", cloud.AuthInfo.Password)
+	th.CheckEquals(t, "RegionTwo", cloud.RegionName)
+	th.CheckEquals(t, "public", cloud.Interface)
+
+	th.CheckDeepEquals(t, gophercloud.TransportOpts{
+		CACertFile: "/etc/ssl/example.pem",
+		Insecure:   true,
+	}, cloud.TransportOpts())
+
+	th.CheckDeepEquals(t, gophercloud.EndpointOpts{
+		Region:       "RegionTwo",
+		Availability: gophercloud.AvailabilityPublic,
+	}, cloud.EndpointOpts())
+
+	ao, err := cloud.AuthOptions()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://example.com/identity/v3",
+		Username:         "me",
+		Password:         "secret",
+		DomainName:       "users",
+		AllowReauth:      true,
+		Scope: &gophercloud.AuthScope{
+			ProjectName: "myproject",
+			DomainName:  "projects",
+		},
+	}, ao)
+}
+
+func TestGetCloudFromOSCloud(t *testing.T) {
+	defer SetupConfig(t, allFiles)()
+	os.Setenv("OS_CLOUD", "tokencloud")
+
+	cloud, err := clientconfig.GetCloud(nil)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, gophercloud.EndpointOpts{
+		Availability: gophercloud.AvailabilityInternal,
+	}, cloud.EndpointOpts())
+
+	ao, err := cloud.AuthOptions()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://example.com/identity/v3",
+		TokenID:          "aToken",
+		AllowReauth:      true,
+		Scope:            &gophercloud.AuthScope{ProjectID: "1234"},
+	}, ao)
+}
+
+func TestGetCloudIdentityV2(t *testing.T) {
+	defer SetupConfig(t, allFiles)()
+
+	cloud, err := clientconfig.GetCloud(&clientconfig.ClientOpts{Cloud: "v2cloud"})
+	th.AssertNoErr(t, err)
+
+	ao, err := cloud.AuthOptions()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://example.com/identity/v2.0",
+		Username:         "me",
+		Password:         "secret",
+		TenantName:       "myproject",
+		AllowReauth:      true,
+	}, ao)
+}
+
+func TestGetCloudEnvOverrides(t *testing.T) {
+	defer SetupConfig(t, allFiles)()
+	os.Setenv("OS_CLOUD", "mycloud")
+	os.Setenv("OS_PASSWORD", "another secret")
+	os.Setenv("OS_TENANT_ID", "5678")
+	os.Setenv("OS_ENDPOINT_TYPE", "admin")
+	os.Setenv("OS_INSECURE", "false")
+
+	cloud, err := clientconfig.GetCloud(nil)
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, "another secret", cloud.AuthInfo.Password)
+	th.CheckEquals(t, "5678", cloud.AuthInfo.ProjectID)
+	th.CheckEquals(t, gophercloud.AvailabilityAdmin, cloud.EndpointOpts().Availability)
+	th.CheckEquals(t, false, cloud.TransportOpts().Insecure)
+
+	ao, err := cloud.AuthOptions()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &gophercloud.AuthScope{ProjectID: "5678"}, ao.Scope)
+}
+
+func TestGetCloudEnvOnly(t *testing.T) {
+	defer SetupConfig(t, nil)()
+	os.Setenv("OS_AUTH_URL", "https://example.com/identity/v3")
+	os.Setenv("OS_USER_ID", "1234")
+	os.Setenv("OS_PASSWORD", "secret")
+	os.Setenv("OS_DOMAIN_NAME", "mydomain")
+	os.Setenv("OS_REGION_NAME", "RegionOne")
+	os.Setenv("OS_CACERT", "/etc/ssl/example.pem")
+
+	cloud, err := clientconfig.GetCloud(nil)
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, "RegionOne", cloud.EndpointOpts().Region)
+	th.CheckEquals(t, "/etc/ssl/example.pem", cloud.TransportOpts().CACertFile)
+
+	// A user ID doesn't need a domain, which is used as the scope instead.
+	ao, err := cloud.AuthOptions()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint: "https://example.com/identity/v3",
+		UserID:           "1234",
+		Password:         "secret",
+		AllowReauth:      true,
+		Scope:            &gophercloud.AuthScope{DomainName: "mydomain"},
+	}, ao)
+}
+
+func TestGetCloudApplicationCredential(t *testing.T) {
+	defer SetupConfig(t, nil)()
+	os.Setenv("OS_AUTH_TYPE", "v3applicationcredential")
+	os.Setenv("OS_AUTH_URL", "https://example.com/identity/v3")
+	os.Setenv("OS_APPLICATION_CREDENTIAL_NAME", "ci")
+	os.Setenv("OS_APPLICATION_CREDENTIAL_SECRET", "secret")
+	os.Setenv("OS_USERNAME", "me")
+	os.Setenv("OS_USER_DOMAIN_NAME", "users")
+	os.Setenv("OS_PROJECT_NAME", "ignored")
+
+	cloud, err := clientconfig.GetCloud(nil)
+	th.AssertNoErr(t, err)
+
+	ao, err := cloud.AuthOptions()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, gophercloud.AuthOptions{
+		IdentityEndpoint:            "https://example.com/identity/v3",
+		Username:                    "me",
+		DomainName:                  "users",
+		ApplicationCredentialName:   "ci",
+		ApplicationCredentialSecret: "secret",
+		AllowReauth:                 true,
+	}, ao)
+}
+
+func TestGetCloudErrors(t *testing.T) {
+	defer SetupConfig(t, map[string]string{"clouds.yaml": CloudsYAML})()
+
+	_, err := clientconfig.GetCloud(&clientconfig.ClientOpts{Cloud: "unknown"})
+	th.AssertEquals(t, "Cloud unknown not found in clouds.yaml", err.Error())
+
+	// The profile of mycloud is defined in the missing clouds-public.yaml.
+	_, err = clientconfig.GetCloud(&clientconfig.ClientOpts{Cloud: "mycloud"})
+	th.AssertEquals(t, "Profile example of cloud mycloud not found in clouds-public.yaml", err.Error())
+
+	cloud, err := clientconfig.GetCloud(nil)
+	th.AssertNoErr(t, err)
+	_, err = cloud.AuthOptions()
+	th.AssertEquals(t, gophercloud.ErrMissingInput{Argument: "auth_url"}, err)
+}
+
+func TestAuthenticatedClient(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	defer SetupConfig(t, map[string]string{"clouds.yaml": fmt.Sprintf(`
+clouds:
+  mycloud:
+    auth:
+      auth_url: %sv3/
+      username: me
+      password: secret
+      user_domain_id: users
+      project_name: myproject
+      project_domain_name: projects
+`, th.Endpoint())})()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["password"],
+						"password": {
+							"user": {
+								"name": "me",
+								"password": "secret",
+								"domain": { "id": "users" }
+							}
+						}
+					},
+					"scope": {
+						"project": {
+							"name": "myproject",
+							"domain": { "name": "projects" }
+						}
+					}
+				}
+			}
+		`)
+
+		w.Header().Add("X-Subject-Token", "aToken")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	client, err := clientconfig.AuthenticatedClient(&clientconfig.ClientOpts{Cloud: "mycloud"})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "aToken", client.Token())
+}
+
+func TestAuthenticatedClientPinnedIdentityV2(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	defer SetupConfig(t, map[string]string{"clouds.yaml": fmt.Sprintf(`
+clouds:
+  mycloud:
+    auth:
+      auth_url: %s
+      username: me
+      password: secret
+      project_name: myproject
+    region_name: RegionOne
+    identity_api_version: 2
+`, th.Endpoint())})()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `
+			{
+				"versions": {
+					"values": [
+						{
+							"status": "stable",
+							"id": "v3.0",
+							"links": [
+								{ "href": "%s", "rel": "self" }
+							]
+						},
+						{
+							"status": "stable",
+							"id": "v2.0",
+							"links": [
+								{ "href": "%s", "rel": "self" }
+							]
+						}
+					]
+				}
+			}
+		`, th.Endpoint()+"v3/", th.Endpoint()+"v2.0/")
+	})
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected identity v3 request for a cloud pinned to v2")
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	th.Mux.HandleFunc("/v2.0/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"passwordCredentials": {
+						"username": "me",
+						"password": "secret"
+					},
+					"tenantName": "myproject"
+				}
+			}
+		`)
+
+		fmt.Fprintf(w, `
+			{
+				"access": {
+					"token": {
+						"id": "aToken",
+						"expires": "2014-10-01T10:00:00.000000Z"
+					},
+					"serviceCatalog": []
+				}
+			}
+		`)
+	})
+
+	client, err := clientconfig.AuthenticatedClient(&clientconfig.ClientOpts{Cloud: "mycloud"})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "aToken", client.Token())
+}
@@ -0,0 +1,2 @@
+// clientconfig
+package testing
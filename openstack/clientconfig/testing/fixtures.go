@@ -0,0 +1,105 @@
+package testing
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// CloudsYAML is a clouds.yaml file, whose "mycloud" cloud inherits from the
+// "example" profile of PublicCloudsYAML and gets its password from SecureYAML.
+const CloudsYAML = `
+clouds:
+  mycloud:
+    profile: example
+    auth:
+      username: me
+      project_name: myproject
+      user_domain_name: users
+      project_domain_name: projects
+    region_name: RegionTwo
+    verify: false
+  tokencloud:
+    auth_type: v3token
+    auth:
+      auth_url: https://example.com/identity/v3
+      token: aToken
+      project_id: 1234
+    interface: internalURL
+  v2cloud:
+    identity_api_version: 2
+    auth:
+      auth_url: https://example.com/identity/v2.0
+      username: me
+      password: secret
+      project_name: myproject
+`
+
+// SecureYAML is a secure.yaml file holding the password of "mycloud".
+const SecureYAML = `
+clouds:
+  mycloud:
+    auth:
+      password: secret
+`
+
+// PublicCloudsYAML is a clouds-public.yaml file defining the "example" profile.
+const PublicCloudsYAML = `
+public-clouds:
+  example:
+    auth:
+      auth_url: https://example.com/identity/v3
+    region_name: RegionOne
+    interface: public
+    cacert: /etc/ssl/example.pem
+`
+
+// SetupConfig writes the given configuration files to a temporary directory,
+// makes it the current directory and clears the OS_* environment variables,
+// so that only those files are read. The returned function undoes it.
+func SetupConfig(t *testing.T, files map[string]string) func() {
+	dir, err := ioutil.TempDir("", "clientconfig")
+	th.AssertNoErr(t, err)
+
+	for name, content := range files {
+		th.AssertNoErr(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+
+	wd, err := os.Getwd()
+	th.AssertNoErr(t, err)
+	th.AssertNoErr(t, os.Chdir(dir))
+
+	env := map[string]string{}
+	for _, kv := range os.Environ() {
+		if strings.HasPrefix(kv, "OS_") {
+			parts := strings.SplitN(kv, "=", 2)
+			env[parts[0]] = parts[1]
+			os.Unsetenv(parts[0])
+		}
+	}
+	// Keep the configuration of the user out of the way.
+	xdg, hasXDG := os.LookupEnv("XDG_CONFIG_HOME")
+	os.Setenv("XDG_CONFIG_HOME", dir)
+
+	return func() {
+		for _, kv := range os.Environ() {
+			if strings.HasPrefix(kv, "OS_") {
+				os.Unsetenv(strings.SplitN(kv, "=", 2)[0])
+			}
+		}
+		for k, v := range env {
+			os.Setenv(k, v)
+		}
+		if hasXDG {
+			os.Setenv("XDG_CONFIG_HOME", xdg)
+		} else {
+			os.Unsetenv("XDG_CONFIG_HOME")
+		}
+		os.Chdir(wd)
+		os.RemoveAll(dir)
+	}
+}
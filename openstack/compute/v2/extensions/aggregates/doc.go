@@ -0,0 +1,67 @@
+/*
+Package aggregates manages host aggregates of the OpenStack Compute service:
+groups of hosts sharing metadata, which the scheduler can match against the
+extra specs of flavors. An aggregate with an availability zone exposes its
+hosts as that zone. It requires administrative privileges.
+
+Example to List Aggregates
+
+	allPages, err := aggregates.List(computeClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAggregates, err := aggregates.ExtractAggregates(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, aggregate := range allAggregates {
+		fmt.Printf("%+v\n", aggregate)
+	}
+
+Example to Create an Aggregate
+
+	createOpts := aggregates.CreateOpts{
+		Name:             "name",
+		AvailabilityZone: "london",
+	}
+
+	aggregate, err := aggregates.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add a Host to an Aggregate
+
+	addHostOpts := aggregates.AddHostOpts{
+		Host: "newhost-cmp1",
+	}
+
+	aggregate, err := aggregates.AddHost(computeClient, aggregateID, addHostOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Set the Metadata of an Aggregate
+
+	setMetadataOpts := aggregates.SetMetadataOpts{
+		Metadata: map[string]interface{}{
+			"ssd": "true",
+			"old": nil, // removes the "old" key
+		},
+	}
+
+	aggregate, err := aggregates.SetMetadata(computeClient, aggregateID, setMetadataOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Aggregate
+
+	err := aggregates.Delete(computeClient, aggregateID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package aggregates
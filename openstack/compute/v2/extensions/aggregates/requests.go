@@ -0,0 +1,185 @@
+package aggregates
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List returns a Pager that allows you to iterate over the aggregates.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
+		return AggregatePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAggregateCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the aggregate to create.
+type CreateOpts struct {
+	// Name is the name of the aggregate.
+	Name string `json:"name" required:"true"`
+
+	// AvailabilityZone is the availability zone the hosts of the aggregate are
+	// exposed as.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToAggregateCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToAggregateCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "aggregate")
+}
+
+// Create creates an aggregate.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAggregateCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Get returns the details of an aggregate.
+func Get(client *gophercloud.ServiceClient, id int) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToAggregateUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of an aggregate to update.
+type UpdateOpts struct {
+	// Name is the new name of the aggregate.
+	Name string `json:"name,omitempty"`
+
+	// AvailabilityZone is the new availability zone of the aggregate.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToAggregateUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToAggregateUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "aggregate")
+}
+
+// Update updates the name or availability zone of an aggregate.
+func Update(client *gophercloud.ServiceClient, id int, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAggregateUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes an aggregate. It must not have any host left.
+func Delete(client *gophercloud.ServiceClient, id int) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// AddHostOptsBuilder allows extensions to add additional parameters to the
+// AddHost request.
+type AddHostOptsBuilder interface {
+	ToAggregateAddHostMap() (map[string]interface{}, error)
+}
+
+// AddHostOpts specifies the host to add to an aggregate.
+type AddHostOpts struct {
+	// Host is the name of the host to add.
+	Host string `json:"host" required:"true"`
+}
+
+// ToAggregateAddHostMap constructs a request body from AddHostOpts.
+func (opts AddHostOpts) ToAggregateAddHostMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "add_host")
+}
+
+// AddHost adds a host to an aggregate.
+func AddHost(client *gophercloud.ServiceClient, id int, opts AddHostOptsBuilder) (r ActionResult) {
+	b, err := opts.ToAggregateAddHostMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// RemoveHostOptsBuilder allows extensions to add additional parameters to the
+// RemoveHost request.
+type RemoveHostOptsBuilder interface {
+	ToAggregateRemoveHostMap() (map[string]interface{}, error)
+}
+
+// RemoveHostOpts specifies the host to remove from an aggregate.
+type RemoveHostOpts struct {
+	// Host is the name of the host to remove.
+	Host string `json:"host" required:"true"`
+}
+
+// ToAggregateRemoveHostMap constructs a request body from RemoveHostOpts.
+func (opts RemoveHostOpts) ToAggregateRemoveHostMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "remove_host")
+}
+
+// RemoveHost removes a host from an aggregate.
+func RemoveHost(client *gophercloud.ServiceClient, id int, opts RemoveHostOptsBuilder) (r ActionResult) {
+	b, err := opts.ToAggregateRemoveHostMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// SetMetadataOptsBuilder allows extensions to add additional parameters to
+// the SetMetadata request.
+type SetMetadataOptsBuilder interface {
+	ToAggregateSetMetadataMap() (map[string]interface{}, error)
+}
+
+// SetMetadataOpts specifies the metadata to set on an aggregate.
+type SetMetadataOpts struct {
+	// Metadata are the keys to set, and their values. The keys with a nil value
+	// are removed; the keys left out are kept as they are.
+	Metadata map[string]interface{} `json:"metadata" required:"true"`
+}
+
+// ToAggregateSetMetadataMap constructs a request body from SetMetadataOpts.
+func (opts SetMetadataOpts) ToAggregateSetMetadataMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "set_metadata")
+}
+
+// SetMetadata sets, updates or removes metadata keys of an aggregate.
+func SetMetadata(client *gophercloud.ServiceClient, id int, opts SetMetadataOptsBuilder) (r ActionResult) {
+	b, err := opts.ToAggregateSetMetadataMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,125 @@
+package aggregates
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Aggregate is a group of hosts sharing metadata.
+type Aggregate struct {
+	// ID is the ID of the aggregate.
+	ID int `json:"id"`
+
+	// UUID is the UUID of the aggregate, from microversion 2.41 on.
+	UUID string `json:"uuid"`
+
+	// Name is the name of the aggregate.
+	Name string `json:"name"`
+
+	// AvailabilityZone is the availability zone the hosts of the aggregate are
+	// exposed as.
+	AvailabilityZone string `json:"availability_zone"`
+
+	// Hosts are the names of the hosts of the aggregate.
+	Hosts []string `json:"hosts"`
+
+	// Metadata is the metadata of the aggregate. It includes the availability
+	// zone, if any.
+	Metadata map[string]string `json:"metadata"`
+
+	// Deleted is whether the aggregate was deleted.
+	Deleted bool `json:"deleted"`
+
+	// CreatedAt, UpdatedAt and DeletedAt are when the aggregate was created,
+	// last updated and deleted. They are the zero time when unset.
+	CreatedAt time.Time `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+	DeletedAt time.Time `json:"-"`
+}
+
+func (r *Aggregate) UnmarshalJSON(b []byte) error {
+	type tmp Aggregate
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+		DeletedAt gophercloud.JSONRFC3339MilliNoZ `json:"deleted_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Aggregate(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+	r.DeletedAt = time.Time(s.DeletedAt)
+
+	return nil
+}
+
+// AggregatePage stores a single, only page of Aggregates from a List call.
+type AggregatePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not an AggregatePage is empty.
+func (page AggregatePage) IsEmpty() (bool, error) {
+	aggregates, err := ExtractAggregates(page)
+	return len(aggregates) == 0, err
+}
+
+// ExtractAggregates interprets a page of results as a slice of Aggregates.
+func ExtractAggregates(r pagination.Page) ([]Aggregate, error) {
+	var s struct {
+		Aggregates []Aggregate `json:"aggregates"`
+	}
+	err := (r.(AggregatePage)).ExtractInto(&s)
+	return s.Aggregates, err
+}
+
+type aggregatesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any aggregate response as an Aggregate.
+func (r aggregatesResult) Extract() (*Aggregate, error) {
+	var s struct {
+		Aggregate *Aggregate `json:"aggregate"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Aggregate, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as an Aggregate.
+type CreateResult struct {
+	aggregatesResult
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as an Aggregate.
+type GetResult struct {
+	aggregatesResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as an Aggregate.
+type UpdateResult struct {
+	aggregatesResult
+}
+
+// ActionResult is the response from an AddHost, RemoveHost or SetMetadata
+// operation. Call its Extract method to interpret it as the updated Aggregate.
+type ActionResult struct {
+	aggregatesResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,2 @@
+// compute_extensions_aggregates_v2
+package testing
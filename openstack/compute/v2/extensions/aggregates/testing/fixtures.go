@@ -0,0 +1,175 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "aggregates": [
+        {
+            "availability_zone": "london",
+            "created_at": "2016-12-27T22:51:32.000000",
+            "deleted": false,
+            "deleted_at": null,
+            "hosts": [
+                "compute"
+            ],
+            "id": 1,
+            "metadata": {
+                "availability_zone": "london"
+            },
+            "name": "name",
+            "updated_at": null,
+            "uuid": "6ba28ba7-f29b-45cc-a30b-6e3a40c2fb14"
+        },
+        {
+            "availability_zone": null,
+            "created_at": "2016-12-27T22:51:32.000000",
+            "deleted": false,
+            "deleted_at": null,
+            "hosts": [],
+            "id": 2,
+            "metadata": {},
+            "name": "test-aggregate",
+            "updated_at": "2016-12-28T10:22:05.215000",
+            "uuid": "fd2ce398-ff2d-43ae-8c3f-b8c39dde6e41"
+        }
+    ]
+}
+`
+
+// AggregateOutput is a sample response to a Create, Get, Update or action
+// call.
+const AggregateOutput = `
+{
+    "aggregate": {
+        "availability_zone": "london",
+        "created_at": "2016-12-27T22:51:32.000000",
+        "deleted": false,
+        "deleted_at": null,
+        "hosts": [
+            "compute"
+        ],
+        "id": 1,
+        "metadata": {
+            "availability_zone": "london"
+        },
+        "name": "name",
+        "updated_at": null,
+        "uuid": "6ba28ba7-f29b-45cc-a30b-6e3a40c2fb14"
+    }
+}
+`
+
+// FirstAggregate is the first result in ListOutput, and the parsed result of
+// AggregateOutput.
+var FirstAggregate = aggregates.Aggregate{
+	ID:               1,
+	UUID:             "6ba28ba7-f29b-45cc-a30b-6e3a40c2fb14",
+	Name:             "name",
+	AvailabilityZone: "london",
+	Hosts:            []string{"compute"},
+	Metadata:         map[string]string{"availability_zone": "london"},
+	CreatedAt:        time.Date(2016, 12, 27, 22, 51, 32, 0, time.UTC),
+}
+
+// SecondAggregate is the second result in ListOutput.
+var SecondAggregate = aggregates.Aggregate{
+	ID:        2,
+	UUID:      "fd2ce398-ff2d-43ae-8c3f-b8c39dde6e41",
+	Name:      "test-aggregate",
+	Hosts:     []string{},
+	Metadata:  map[string]string{},
+	CreatedAt: time.Date(2016, 12, 27, 22, 51, 32, 0, time.UTC),
+	UpdatedAt: time.Date(2016, 12, 28, 10, 22, 5, 215000000, time.UTC),
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-aggregates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-aggregates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "aggregate": {
+        "name": "name",
+        "availability_zone": "london"
+    }
+}
+`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, AggregateOutput)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-aggregates/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, AggregateOutput)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-aggregates/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"aggregate": {"name": "name"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, AggregateOutput)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-aggregates/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// HandleActionSuccessfully configures the test server to respond to an
+// action request with the given body.
+func HandleActionSuccessfully(t *testing.T, body string) {
+	th.Mux.HandleFunc("/os-aggregates/1/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, body)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, AggregateOutput)
+	})
+}
@@ -0,0 +1,112 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := aggregates.List(client.ServiceClient()).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := aggregates.ExtractAggregates(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []aggregates.Aggregate{FirstAggregate, SecondAggregate}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := aggregates.CreateOpts{Name: "name", AvailabilityZone: "london"}
+	actual, err := aggregates.Create(client.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAggregate, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := aggregates.Get(client.ServiceClient(), 1).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAggregate, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	actual, err := aggregates.Update(client.ServiceClient(), 1, aggregates.UpdateOpts{Name: "name"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAggregate, actual)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := aggregates.Delete(client.ServiceClient(), 1).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAddHost(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, `{"add_host": {"host": "compute"}}`)
+
+	actual, err := aggregates.AddHost(client.ServiceClient(), 1, aggregates.AddHostOpts{Host: "compute"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAggregate, actual)
+}
+
+func TestRemoveHost(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, `{"remove_host": {"host": "compute2"}}`)
+
+	actual, err := aggregates.RemoveHost(client.ServiceClient(), 1, aggregates.RemoveHostOpts{Host: "compute2"}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAggregate, actual)
+}
+
+func TestSetMetadata(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleActionSuccessfully(t, `{"set_metadata": {"metadata": {"ssd": "true", "old": null}}}`)
+
+	opts := aggregates.SetMetadataOpts{
+		Metadata: map[string]interface{}{"ssd": "true", "old": nil},
+	}
+	actual, err := aggregates.SetMetadata(client.ServiceClient(), 1, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstAggregate, actual)
+}
+
+func TestRequiredOpts(t *testing.T) {
+	_, err := aggregates.CreateOpts{}.ToAggregateCreateMap()
+	if err == nil {
+		t.Fatal("CreateOpts without a Name should fail")
+	}
+	_, err = aggregates.AddHostOpts{}.ToAggregateAddHostMap()
+	if err == nil {
+		t.Fatal("AddHostOpts without a Host should fail")
+	}
+}
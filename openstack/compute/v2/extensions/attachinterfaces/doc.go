@@ -0,0 +1,58 @@
+/*
+Package attachinterfaces provides the ability to attach and detach network
+interfaces to and from servers, as Neutron ports.
+
+Example to List a Server's Interfaces
+
+	allPages, err := attachinterfaces.List(computeClient, serverID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allInterfaces, err := attachinterfaces.ExtractInterfaces(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, iface := range allInterfaces {
+		fmt.Printf("%s: %s\n", iface.PortID, iface.PortState)
+	}
+
+Example to Attach an Existing Port to a Server
+
+	createOpts := attachinterfaces.CreateOpts{
+		PortID: "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+	}
+
+	iface, err := attachinterfaces.Create(computeClient, serverID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = attachinterfaces.WaitForPortState(computeClient, serverID, iface.PortID, "ACTIVE", 60)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Attach a New Port on a Network, with a Fixed IP
+
+	createOpts := attachinterfaces.CreateOpts{
+		NetworkID: "8a5fe506-7e9f-4091-899b-96336909d93c",
+		FixedIPs: []attachinterfaces.FixedIP{
+			{IPAddress: "192.168.0.10"},
+		},
+	}
+
+	iface, err := attachinterfaces.Create(computeClient, serverID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Detach an Interface from a Server
+
+	err := attachinterfaces.Delete(computeClient, serverID, portID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package attachinterfaces
@@ -0,0 +1,82 @@
+package attachinterfaces
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// List returns a Pager that allows you to iterate over the interfaces of a
+// server.
+func List(client *gophercloud.ServiceClient, serverID string) pagination.Pager {
+	return pagination.NewPager(client, listURL(client, serverID), func(r pagination.PageResult) pagination.Page {
+		return InterfacePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get returns the interface of a server attached to a port.
+func Get(client *gophercloud.ServiceClient, serverID, portID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, serverID, portID), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToAttachInterfacesCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies the interface to attach. Either an existing port is
+// attached with PortID, or a new port is created on NetworkID. With neither,
+// Nova picks the network.
+type CreateOpts struct {
+	// PortID is the ID of the port to attach.
+	PortID string `json:"port_id,omitempty"`
+
+	// NetworkID is the ID of the network to create the port on.
+	NetworkID string `json:"net_id,omitempty"`
+
+	// FixedIPs are the IP addresses of the port to create. They require
+	// NetworkID.
+	FixedIPs []FixedIP `json:"fixed_ips,omitempty"`
+
+	// Tag is a device role tag for the interface, from microversion 2.49 on.
+	Tag string `json:"tag,omitempty"`
+}
+
+// ToAttachInterfacesCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToAttachInterfacesCreateMap() (map[string]interface{}, error) {
+	if opts.PortID != "" && opts.NetworkID != "" {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "PortID/NetworkID"
+		err.Info = "Only one of PortID and NetworkID can be provided"
+		return nil, err
+	}
+	if len(opts.FixedIPs) > 0 && opts.NetworkID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "NetworkID"
+		err.Info = "FixedIPs require a NetworkID"
+		return nil, err
+	}
+	return gophercloud.BuildRequestBody(opts, "interfaceAttachment")
+}
+
+// Create attaches an interface to a server. The port may still be building
+// when it returns; use WaitForPortState to wait for it to be active.
+func Create(client *gophercloud.ServiceClient, serverID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToAttachInterfacesCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, serverID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete detaches the interface attached to a port from a server. A port
+// created by Create on a network is deleted with it.
+func Delete(client *gophercloud.ServiceClient, serverID, portID string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, serverID, portID), nil)
+	return
+}
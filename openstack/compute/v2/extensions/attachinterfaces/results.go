@@ -0,0 +1,88 @@
+package attachinterfaces
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// FixedIP is an IP address of an interface.
+type FixedIP struct {
+	// SubnetID is the ID of the subnet of the IP address. It's ignored by
+	// Create.
+	SubnetID string `json:"subnet_id,omitempty"`
+
+	// IPAddress is the IP address.
+	IPAddress string `json:"ip_address"`
+}
+
+// Interface is a network interface of a server, backed by a Neutron port.
+type Interface struct {
+	// PortID is the ID of the port of the interface.
+	PortID string `json:"port_id"`
+
+	// NetID is the ID of the network of the port.
+	NetID string `json:"net_id"`
+
+	// PortState is the status of the port, like "ACTIVE" or "DOWN".
+	PortState string `json:"port_state"`
+
+	// FixedIPs are the IP addresses of the port.
+	FixedIPs []FixedIP `json:"fixed_ips"`
+
+	// MACAddr is the MAC address of the port.
+	MACAddr string `json:"mac_addr"`
+
+	// Tag is the device role tag of the interface, from microversion 2.70 on.
+	Tag string `json:"tag"`
+}
+
+// InterfacePage stores a single, only page of Interfaces from a List call.
+type InterfacePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not an InterfacePage is empty.
+func (page InterfacePage) IsEmpty() (bool, error) {
+	interfaces, err := ExtractInterfaces(page)
+	return len(interfaces) == 0, err
+}
+
+// ExtractInterfaces interprets a page of results as a slice of Interfaces.
+func ExtractInterfaces(r pagination.Page) ([]Interface, error) {
+	var s struct {
+		Interfaces []Interface `json:"interfaceAttachments"`
+	}
+	err := (r.(InterfacePage)).ExtractInto(&s)
+	return s.Interfaces, err
+}
+
+type attachInterfaceResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any attachInterfaceResult as an Interface.
+func (r attachInterfaceResult) Extract() (*Interface, error) {
+	var s struct {
+		Interface *Interface `json:"interfaceAttachment"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Interface, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as an Interface.
+type GetResult struct {
+	attachInterfaceResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as an Interface.
+type CreateResult struct {
+	attachInterfaceResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
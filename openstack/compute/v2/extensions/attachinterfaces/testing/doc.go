@@ -0,0 +1,2 @@
+// compute_extensions_attachinterfaces_v2
+package testing
@@ -0,0 +1,152 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/attachinterfaces"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "interfaceAttachments": [
+        {
+            "port_state": "ACTIVE",
+            "fixed_ips": [
+                {
+                    "subnet_id": "d7906db4-a566-4546-b1f4-5c7fa70f0bf3",
+                    "ip_address": "10.0.0.7"
+                },
+                {
+                    "subnet_id": "45906d64-a548-4276-h1f8-kcffa80fjbnl",
+                    "ip_address": "10.0.0.8"
+                }
+            ],
+            "port_id": "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+            "net_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+            "mac_addr": "fa:16:3e:38:2d:80"
+        }
+    ]
+}
+`
+
+// InterfaceOutput is a sample response to a Get or Create call.
+const InterfaceOutput = `
+{
+    "interfaceAttachment": {
+        "port_state": "ACTIVE",
+        "fixed_ips": [
+            {
+                "subnet_id": "d7906db4-a566-4546-b1f4-5c7fa70f0bf3",
+                "ip_address": "10.0.0.7"
+            },
+            {
+                "subnet_id": "45906d64-a548-4276-h1f8-kcffa80fjbnl",
+                "ip_address": "10.0.0.8"
+            }
+        ],
+        "port_id": "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+        "net_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+        "mac_addr": "fa:16:3e:38:2d:80"
+    }
+}
+`
+
+// ExpectedInterface is the parsed result of InterfaceOutput, and the only
+// result in ListOutput.
+var ExpectedInterface = attachinterfaces.Interface{
+	PortState: "ACTIVE",
+	FixedIPs: []attachinterfaces.FixedIP{
+		{
+			SubnetID:  "d7906db4-a566-4546-b1f4-5c7fa70f0bf3",
+			IPAddress: "10.0.0.7",
+		},
+		{
+			SubnetID:  "45906d64-a548-4276-h1f8-kcffa80fjbnl",
+			IPAddress: "10.0.0.8",
+		},
+	},
+	PortID:  "0dde1598-b374-474e-986f-5b8dd1df1d4e",
+	NetID:   "8a5fe506-7e9f-4091-899b-96336909d93c",
+	MACAddr: "fa:16:3e:38:2d:80",
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/b07e7a3b-d951-4efc-a4f9-ac9f001afb7f/os-interface", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/b07e7a3b-d951-4efc-a4f9-ac9f001afb7f/os-interface/0dde1598-b374-474e-986f-5b8dd1df1d4e", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, InterfaceOutput)
+	})
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request on a network, with a fixed IP.
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/b07e7a3b-d951-4efc-a4f9-ac9f001afb7f/os-interface", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "interfaceAttachment": {
+        "net_id": "8a5fe506-7e9f-4091-899b-96336909d93c",
+        "fixed_ips": [
+            {
+                "ip_address": "10.0.0.7"
+            }
+        ]
+    }
+}
+`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, InterfaceOutput)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/b07e7a3b-d951-4efc-a4f9-ac9f001afb7f/os-interface/0dde1598-b374-474e-986f-5b8dd1df1d4e", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandlePortStatesSuccessfully configures the test server to respond to Get
+// requests with the given port states in turn, repeating the last one.
+func HandlePortStatesSuccessfully(t *testing.T, states ...string) {
+	th.Mux.HandleFunc("/servers/b07e7a3b-d951-4efc-a4f9-ac9f001afb7f/os-interface/0dde1598-b374-474e-986f-5b8dd1df1d4e", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		state := states[0]
+		if len(states) > 1 {
+			states = states[1:]
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"interfaceAttachment": {"port_id": "0dde1598-b374-474e-986f-5b8dd1df1d4e", "port_state": "%s"}}`, state)
+	})
+}
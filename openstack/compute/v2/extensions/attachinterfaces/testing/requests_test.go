@@ -0,0 +1,125 @@
+package testing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/attachinterfaces"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const (
+	serverID = "b07e7a3b-d951-4efc-a4f9-ac9f001afb7f"
+	portID   = "0dde1598-b374-474e-986f-5b8dd1df1d4e"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	count := 0
+	err := attachinterfaces.List(client.ServiceClient(), serverID).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := attachinterfaces.ExtractInterfaces(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []attachinterfaces.Interface{ExpectedInterface}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := attachinterfaces.Get(client.ServiceClient(), serverID, portID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedInterface, actual)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t)
+
+	opts := attachinterfaces.CreateOpts{
+		NetworkID: "8a5fe506-7e9f-4091-899b-96336909d93c",
+		FixedIPs: []attachinterfaces.FixedIP{
+			{IPAddress: "10.0.0.7"},
+		},
+	}
+	actual, err := attachinterfaces.Create(client.ServiceClient(), serverID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedInterface, actual)
+}
+
+func TestCreateInvalidOpts(t *testing.T) {
+	_, err := attachinterfaces.CreateOpts{
+		PortID:    portID,
+		NetworkID: "8a5fe506-7e9f-4091-899b-96336909d93c",
+	}.ToAttachInterfacesCreateMap()
+	if err == nil {
+		t.Fatal("CreateOpts with both a PortID and a NetworkID should fail")
+	}
+
+	_, err = attachinterfaces.CreateOpts{
+		PortID:   portID,
+		FixedIPs: []attachinterfaces.FixedIP{{IPAddress: "10.0.0.7"}},
+	}.ToAttachInterfacesCreateMap()
+	if err == nil {
+		t.Fatal("CreateOpts with FixedIPs but no NetworkID should fail")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := attachinterfaces.Delete(client.ServiceClient(), serverID, portID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForPortState(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePortStatesSuccessfully(t, "BUILD", "DOWN", "ACTIVE")
+
+	err := attachinterfaces.WaitForPortStateContext(context.Background(), client.ServiceClient(), serverID, portID, "ACTIVE", gophercloud.WaitOpts{
+		Timeout:  5 * time.Second,
+		Interval: time.Millisecond,
+	})
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForPortStateFailsOnError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePortStatesSuccessfully(t, "BUILD", "ERROR")
+
+	err := attachinterfaces.WaitForPortStateContext(context.Background(), client.ServiceClient(), serverID, portID, "ACTIVE", gophercloud.WaitOpts{
+		Timeout:  5 * time.Second,
+		Interval: time.Millisecond,
+	})
+	th.AssertEquals(t, gophercloud.ErrUnexpectedStatus{Expected: "ACTIVE", Actual: "ERROR"}, err)
+}
+
+func TestWaitForPortStateTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePortStatesSuccessfully(t, "BUILD")
+
+	err := attachinterfaces.WaitForPortStateContext(context.Background(), client.ServiceClient(), serverID, portID, "ACTIVE", gophercloud.WaitOpts{
+		Timeout:  50 * time.Millisecond,
+		Interval: time.Millisecond,
+	})
+	th.AssertEquals(t, gophercloud.ErrTimeOut{}, err)
+}
@@ -0,0 +1,25 @@
+package attachinterfaces
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "os-interface"
+
+func resourceURL(c *gophercloud.ServiceClient, serverID string) string {
+	return c.ServiceURL("servers", serverID, resourcePath)
+}
+
+func listURL(c *gophercloud.ServiceClient, serverID string) string {
+	return resourceURL(c, serverID)
+}
+
+func createURL(c *gophercloud.ServiceClient, serverID string) string {
+	return resourceURL(c, serverID)
+}
+
+func getURL(c *gophercloud.ServiceClient, serverID, portID string) string {
+	return c.ServiceURL("servers", serverID, resourcePath, portID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, serverID, portID string) string {
+	return getURL(c, serverID, portID)
+}
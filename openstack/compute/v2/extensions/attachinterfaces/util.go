@@ -0,0 +1,32 @@
+package attachinterfaces
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// WaitForPortState polls the interface of a server attached to a port until
+// the port reaches state, for at most secs seconds. It fails fast with an
+// ErrUnexpectedStatus when the port enters the ERROR state.
+func WaitForPortState(c *gophercloud.ServiceClient, serverID, portID, state string, secs int) error {
+	return gophercloud.WaitForStatus(secs, func() (string, error) {
+		return getPortState(c, serverID, portID)
+	}, state, "ERROR")
+}
+
+// WaitForPortStateContext is the context-aware version of WaitForPortState,
+// polling as configured by opts.
+func WaitForPortStateContext(ctx context.Context, c *gophercloud.ServiceClient, serverID, portID, state string, opts gophercloud.WaitOpts) error {
+	return gophercloud.WaitForStatusContext(ctx, opts, func(ctx context.Context) (string, error) {
+		return getPortState(c.WithContext(ctx), serverID, portID)
+	}, state, "ERROR")
+}
+
+func getPortState(c *gophercloud.ServiceClient, serverID, portID string) (string, error) {
+	iface, err := Get(c, serverID, portID).Extract()
+	if err != nil {
+		return "", err
+	}
+	return iface.PortState, nil
+}
@@ -0,0 +1,42 @@
+/*
+Package availabilityzones lists the availability zones of the OpenStack
+Compute service. The detailed list, which includes the hosts of each zone and
+the state of their services, requires administrative privileges.
+
+Example to List Availability Zones
+
+	allPages, err := availabilityzones.List(computeClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allZones, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, zone := range allZones {
+		fmt.Printf("%s: available=%t\n", zone.ZoneName, zone.ZoneState.Available)
+	}
+
+Example to List the Hosts of the Availability Zones
+
+	allPages, err := availabilityzones.ListDetail(computeClient).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allZones, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, zone := range allZones {
+		for hostName, services := range zone.Hosts {
+			for serviceName, state := range services {
+				fmt.Printf("%s %s %s: active=%t\n", zone.ZoneName, hostName, serviceName, state.Active)
+			}
+		}
+	}
+*/
+package availabilityzones
@@ -0,0 +1,86 @@
+package availabilityzones
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ServiceState is the state of a service on a host of an availability zone.
+type ServiceState struct {
+	// Active is whether the service is enabled.
+	Active bool `json:"active"`
+
+	// Available is whether the service is up.
+	Available bool `json:"available"`
+
+	// UpdatedAt is when the service last reported its state.
+	UpdatedAt time.Time `json:"-"`
+}
+
+func (r *ServiceState) UnmarshalJSON(b []byte) error {
+	type tmp ServiceState
+	var s struct {
+		tmp
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ServiceState(s.tmp)
+
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// Services maps the names of the services of a host to their state.
+type Services map[string]ServiceState
+
+// Hosts maps the names of the hosts of an availability zone to their
+// services.
+type Hosts map[string]Services
+
+// ZoneState is the state of an availability zone.
+type ZoneState struct {
+	// Available is whether the availability zone can be used.
+	Available bool `json:"available"`
+}
+
+// AvailabilityZone is an availability zone of the Compute service.
+type AvailabilityZone struct {
+	// ZoneName is the name of the availability zone.
+	ZoneName string `json:"zoneName"`
+
+	// ZoneState is the state of the availability zone.
+	ZoneState ZoneState `json:"zoneState"`
+
+	// Hosts are the hosts of the availability zone. It's only set by
+	// ListDetail.
+	Hosts Hosts `json:"hosts"`
+}
+
+// AvailabilityZonePage stores a single, only page of AvailabilityZones from a
+// List or ListDetail call.
+type AvailabilityZonePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not an AvailabilityZonePage is empty.
+func (page AvailabilityZonePage) IsEmpty() (bool, error) {
+	zones, err := ExtractAvailabilityZones(page)
+	return len(zones) == 0, err
+}
+
+// ExtractAvailabilityZones interprets a page of results as a slice of
+// AvailabilityZones.
+func ExtractAvailabilityZones(r pagination.Page) ([]AvailabilityZone, error) {
+	var s struct {
+		AvailabilityZones []AvailabilityZone `json:"availabilityZoneInfo"`
+	}
+	err := (r.(AvailabilityZonePage)).ExtractInto(&s)
+	return s.AvailabilityZones, err
+}
@@ -0,0 +1,2 @@
+// compute_extensions_availabilityzones_v2
+package testing
@@ -0,0 +1,134 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "availabilityZoneInfo": [
+        {
+            "hosts": null,
+            "zoneName": "nova",
+            "zoneState": {
+                "available": true
+            }
+        }
+    ]
+}
+`
+
+// ListDetailOutput is a sample response to a ListDetail call.
+const ListDetailOutput = `
+{
+    "availabilityZoneInfo": [
+        {
+            "hosts": {
+                "localhost": {
+                    "nova-conductor": {
+                        "active": true,
+                        "available": true,
+                        "updated_at": "2017-12-22T10:16:07.000000"
+                    },
+                    "nova-scheduler": {
+                        "active": true,
+                        "available": true,
+                        "updated_at": null
+                    }
+                }
+            },
+            "zoneName": "internal",
+            "zoneState": {
+                "available": true
+            }
+        },
+        {
+            "hosts": {
+                "compute1": {
+                    "nova-compute": {
+                        "active": false,
+                        "available": false,
+                        "updated_at": "2017-12-22T10:16:05.527000"
+                    }
+                }
+            },
+            "zoneName": "nova",
+            "zoneState": {
+                "available": false
+            }
+        }
+    ]
+}
+`
+
+// ExpectedZones is the parsed result of ListOutput.
+var ExpectedZones = []availabilityzones.AvailabilityZone{
+	{
+		ZoneName:  "nova",
+		ZoneState: availabilityzones.ZoneState{Available: true},
+	},
+}
+
+// ExpectedDetailedZones is the parsed result of ListDetailOutput.
+var ExpectedDetailedZones = []availabilityzones.AvailabilityZone{
+	{
+		ZoneName:  "internal",
+		ZoneState: availabilityzones.ZoneState{Available: true},
+		Hosts: availabilityzones.Hosts{
+			"localhost": availabilityzones.Services{
+				"nova-conductor": availabilityzones.ServiceState{
+					Active:    true,
+					Available: true,
+					UpdatedAt: time.Date(2017, 12, 22, 10, 16, 7, 0, time.UTC),
+				},
+				"nova-scheduler": availabilityzones.ServiceState{
+					Active:    true,
+					Available: true,
+				},
+			},
+		},
+	},
+	{
+		ZoneName:  "nova",
+		ZoneState: availabilityzones.ZoneState{Available: false},
+		Hosts: availabilityzones.Hosts{
+			"compute1": availabilityzones.Services{
+				"nova-compute": availabilityzones.ServiceState{
+					UpdatedAt: time.Date(2017, 12, 22, 10, 16, 5, 527000000, time.UTC),
+				},
+			},
+		},
+	},
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-availability-zone", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleListDetailSuccessfully configures the test server to respond to a
+// ListDetail request.
+func HandleListDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-availability-zone/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListDetailOutput)
+	})
+}
@@ -0,0 +1,6 @@
+/*
+Package consoleoutput provides functionality to retrieve the console output,
+like the boot log, of servers that have been provisioned by the OpenStack
+Compute service.
+*/
+package consoleoutput
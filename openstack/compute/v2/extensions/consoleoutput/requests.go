@@ -0,0 +1,39 @@
+package consoleoutput
+
+import "github.com/gophercloud/gophercloud"
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// ShowOptsBuilder allows extensions to add additional parameters to the Show
+// request.
+type ShowOptsBuilder interface {
+	ToConsoleOutputShowMap() (map[string]interface{}, error)
+}
+
+// ShowOpts specifies parameters of a Show request.
+type ShowOpts struct {
+	// Length is the number of lines of output to retrieve, from the end. When
+	// zero, the whole output is retrieved.
+	Length int `json:"length,omitempty"`
+}
+
+// ToConsoleOutputShowMap builds a request body from ShowOpts.
+func (opts ShowOpts) ToConsoleOutputShowMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "os-getConsoleOutput")
+}
+
+// Show is the operation responsible for retrieving the console output of a
+// Compute server.
+func Show(client *gophercloud.ServiceClient, id string, opts ShowOptsBuilder) (r ShowResult) {
+	b, err := opts.ToConsoleOutputShowMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,18 @@
+package consoleoutput
+
+import "github.com/gophercloud/gophercloud"
+
+// ShowResult is the response from a Show operation. Call its Extract method to
+// retrieve the console output.
+type ShowResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ShowResult as the console output of a server.
+func (r ShowResult) Extract() (string, error) {
+	var s struct {
+		Output string `json:"output"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Output, err
+}
@@ -0,0 +1,2 @@
+// compute_extensions_consoleoutput_v2
+package testing
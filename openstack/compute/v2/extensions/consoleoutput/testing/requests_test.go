@@ -0,0 +1,33 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/consoleoutput"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestShow(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockShowResponse(t, serverID, `{"os-getConsoleOutput": {"length": 50}}`)
+
+	output, err := consoleoutput.Show(client.ServiceClient(), serverID, consoleoutput.ShowOpts{Length: 50}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ConsoleOutput, output)
+}
+
+func TestShowWholeOutput(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockShowResponse(t, serverID, `{"os-getConsoleOutput": {}}`)
+
+	output, err := consoleoutput.Show(client.ServiceClient(), serverID, consoleoutput.ShowOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ConsoleOutput, output)
+}
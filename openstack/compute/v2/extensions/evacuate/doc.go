@@ -0,0 +1,6 @@
+/*
+Package evacuate provides functionality to evacuate servers that have been
+provisioned by the OpenStack Compute service from a failed host, rebuilding
+them on another one. It requires administrative privileges.
+*/
+package evacuate
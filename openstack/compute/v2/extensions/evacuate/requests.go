@@ -0,0 +1,70 @@
+package evacuate
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// EvacuateOptsBuilder allows extensions to add additional parameters to the
+// Evacuate request.
+type EvacuateOptsBuilder interface {
+	ToEvacuateMap() (map[string]interface{}, error)
+}
+
+// EvacuateOpts specifies parameters of an Evacuate request.
+type EvacuateOpts struct {
+	// Host is the host the server is rebuilt on. When empty, the scheduler
+	// picks one.
+	Host string `json:"host,omitempty"`
+
+	// AdminPass is the administrative password of the rebuilt server. When
+	// empty, one is generated.
+	AdminPass string `json:"adminPass,omitempty"`
+
+	// OnSharedStorage tells whether the disks of the server are on storage
+	// shared with the destination host. It's required before microversion
+	// 2.14, and no longer accepted from it on.
+	OnSharedStorage *bool `json:"onSharedStorage,omitempty"`
+
+	// Force bypasses the checks of the scheduler on Host. It requires
+	// microversion 2.29, and is no longer accepted from 2.68.
+	Force *bool `json:"force,omitempty"`
+}
+
+// ToEvacuateMap builds a request body from EvacuateOpts.
+func (opts EvacuateOpts) ToEvacuateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "evacuate")
+}
+
+// Evacuate is the operation responsible for evacuating a Compute server from
+// its host, when the host is down.
+func Evacuate(client *gophercloud.ServiceClient, id string, opts EvacuateOptsBuilder) (r EvacuateResult) {
+	b, err := opts.ToEvacuateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(actionURL(client, id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		r.Err = err
+		return
+	}
+	defer resp.Body.Close()
+
+	// From microversion 2.14 on, the response has no body.
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil || len(body) == 0 {
+		r.Err = err
+		return
+	}
+	r.Err = json.Unmarshal(body, &r.Body)
+	return
+}
@@ -0,0 +1,20 @@
+package evacuate
+
+import "github.com/gophercloud/gophercloud"
+
+// EvacuateResult is the response from an Evacuate operation. Call its Extract
+// method to retrieve the administrative password of the rebuilt server.
+type EvacuateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an EvacuateResult as the administrative password of the
+// rebuilt server. It's empty from microversion 2.14 on, unless the password
+// was given in EvacuateOpts.
+func (r EvacuateResult) Extract() (string, error) {
+	var s struct {
+		AdminPass string `json:"adminPass"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AdminPass, err
+}
@@ -0,0 +1,2 @@
+// compute_extensions_evacuate_v2
+package testing
@@ -0,0 +1,38 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/evacuate"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestEvacuate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockEvacuateResponse(t, serverID)
+
+	onSharedStorage := false
+	opts := evacuate.EvacuateOpts{
+		Host:            "compute-02",
+		OnSharedStorage: &onSharedStorage,
+	}
+	adminPass, err := evacuate.Evacuate(client.ServiceClient(), serverID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "MySecretPass", adminPass)
+}
+
+func TestEvacuateScheduled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockEvacuateNoBodyResponse(t, serverID)
+
+	adminPass, err := evacuate.Evacuate(client.ServiceClient(), serverID, evacuate.EvacuateOpts{}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", adminPass)
+}
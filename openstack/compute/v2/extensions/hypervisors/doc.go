@@ -0,0 +1,31 @@
+/*
+Package hypervisors provides information about the hypervisors of the
+OpenStack Compute service, and the resources they use. It requires
+administrative privileges.
+
+Example to List Hypervisors
+
+	allPages, err := hypervisors.ListDetail(computeClient, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allHypervisors, err := hypervisors.ExtractHypervisors(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, hypervisor := range allHypervisors {
+		fmt.Printf("%s: %d/%d vCPUs\n", hypervisor.HypervisorHostname, hypervisor.VCPUsUsed, hypervisor.VCPUs)
+	}
+
+Example to Show the Statistics of All Hypervisors
+
+	stats, err := hypervisors.GetStatistics(computeClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%d MB of RAM free on %d hypervisors\n", stats.FreeRamMB, stats.Count)
+*/
+package hypervisors
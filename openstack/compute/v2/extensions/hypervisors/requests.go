@@ -0,0 +1,86 @@
+package hypervisors
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToHypervisorListQuery() (string, error)
+}
+
+// ListOpts allows to filter and page the hypervisors listed. Its options
+// require microversion 2.33 for paging, and 2.53 for the others.
+type ListOpts struct {
+	// Marker and Limit control paging. Marker is the ID of the last hypervisor
+	// of the previous page.
+	Marker string `q:"marker"`
+	Limit  int    `q:"limit"`
+
+	// HypervisorHostnamePattern only lists the hypervisors whose host name
+	// contains it.
+	HypervisorHostnamePattern string `q:"hypervisor_hostname_pattern"`
+
+	// WithServers lists the servers running on each hypervisor.
+	WithServers *bool `q:"with_servers"`
+}
+
+// ToHypervisorListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToHypervisorListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager that allows you to iterate over the hypervisors, with
+// their ID, host name, state and status only.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listURL(client), opts)
+}
+
+// ListDetail returns a Pager that allows you to iterate over the hypervisors,
+// with all their details.
+func ListDetail(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	return list(client, listDetailURL(client), opts)
+}
+
+func list(client *gophercloud.ServiceClient, url string, opts ListOptsBuilder) pagination.Pager {
+	if opts != nil {
+		query, err := opts.ToHypervisorListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return HypervisorPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get returns the details of a hypervisor.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// GetStatistics returns the sum of the resources of all the hypervisors.
+func GetStatistics(client *gophercloud.ServiceClient) (r StatisticsResult) {
+	_, r.Err = client.Get(statisticsURL(client), &r.Body, nil)
+	return
+}
+
+// GetUptime returns the uptime of a hypervisor, as reported by the uptime
+// command of its host.
+func GetUptime(client *gophercloud.ServiceClient, id string) (r UptimeResult) {
+	_, r.Err = client.Get(uptimeURL(client, id), &r.Body, nil)
+	return
+}
+
+// ListServers returns the hypervisors whose host name contains
+// hostnamePattern, with the servers running on them. From microversion 2.53
+// on, use List with the WithServers option instead.
+func ListServers(client *gophercloud.ServiceClient, hostnamePattern string) (r ListServersResult) {
+	_, r.Err = client.Get(serversURL(client, hostnamePattern), &r.Body, nil)
+	return
+}
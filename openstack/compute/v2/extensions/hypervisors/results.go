@@ -0,0 +1,304 @@
+package hypervisors
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Hypervisor represents a hypervisor, and the resources of its host.
+type Hypervisor struct {
+	// ID is the ID of the hypervisor: an integer before microversion 2.53,
+	// a UUID from it on.
+	ID string `json:"-"`
+
+	// HypervisorHostname is the host name of the hypervisor.
+	HypervisorHostname string `json:"hypervisor_hostname"`
+
+	// State is the state of the hypervisor, "up" or "down".
+	State string `json:"state"`
+
+	// Status is the status of the hypervisor, "enabled" or "disabled".
+	Status string `json:"status"`
+
+	// HypervisorType is the type of the hypervisor, like "QEMU".
+	HypervisorType string `json:"hypervisor_type"`
+
+	// HypervisorVersion is the version of the hypervisor.
+	HypervisorVersion int `json:"hypervisor_version"`
+
+	// HostIP is the IP address of the host of the hypervisor.
+	HostIP string `json:"host_ip"`
+
+	// CPUInfo describes the CPU of the host, as a map.
+	CPUInfo map[string]interface{} `json:"-"`
+
+	// VCPUs and VCPUsUsed are the number of vCPUs of the hypervisor, and the
+	// number of those in use.
+	VCPUs     int `json:"vcpus"`
+	VCPUsUsed int `json:"vcpus_used"`
+
+	// MemoryMB, MemoryMBUsed and FreeRamMB are the RAM of the hypervisor, in
+	// MB, the RAM in use, and the RAM left.
+	MemoryMB     int `json:"memory_mb"`
+	MemoryMBUsed int `json:"memory_mb_used"`
+	FreeRamMB    int `json:"free_ram_mb"`
+
+	// LocalGB, LocalGBUsed and FreeDiskGB are the local disk space of the
+	// hypervisor, in GB, the space in use, and the space left.
+	LocalGB     int `json:"local_gb"`
+	LocalGBUsed int `json:"local_gb_used"`
+	FreeDiskGB  int `json:"free_disk_gb"`
+
+	// DiskAvailableLeast is the disk space left once the disks of the servers
+	// are fully allocated, in GB.
+	DiskAvailableLeast int `json:"disk_available_least"`
+
+	// CurrentWorkload is the number of tasks the hypervisor is working on.
+	CurrentWorkload int `json:"current_workload"`
+
+	// RunningVMs is the number of servers running on the hypervisor.
+	RunningVMs int `json:"running_vms"`
+
+	// Service is the compute service running the hypervisor.
+	Service Service `json:"service"`
+
+	// Servers are the servers running on the hypervisor, when requested.
+	Servers []Server `json:"servers"`
+}
+
+// Service is the compute service running a hypervisor.
+type Service struct {
+	// ID is the ID of the service: an integer before microversion 2.53, a UUID
+	// from it on.
+	ID string `json:"-"`
+
+	// Host is the host of the service.
+	Host string `json:"host"`
+
+	// DisabledReason is the reason the service was disabled for.
+	DisabledReason string `json:"disabled_reason"`
+}
+
+// Server is a server running on a hypervisor.
+type Server struct {
+	// Name is the name of the server.
+	Name string `json:"name"`
+
+	// UUID is the ID of the server.
+	UUID string `json:"uuid"`
+}
+
+// stringID converts an ID, a number or a string, to a string.
+func stringID(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	var id interface{}
+	if err := json.Unmarshal(raw, &id); err != nil {
+		return "", err
+	}
+	switch v := id.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return fmt.Sprintf("%d", int64(v)), nil
+	}
+	return "", fmt.Errorf("Unexpected ID: %s", raw)
+}
+
+func (r *Hypervisor) UnmarshalJSON(b []byte) error {
+	type tmp Hypervisor
+	var s struct {
+		tmp
+		ID      json.RawMessage `json:"id"`
+		CPUInfo json.RawMessage `json:"cpu_info"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = Hypervisor(s.tmp)
+
+	r.ID, err = stringID(s.ID)
+	if err != nil {
+		return err
+	}
+
+	// The CPU info is a JSON document within a string before microversion
+	// 2.28, and an object from it on.
+	if len(s.CPUInfo) > 0 && string(s.CPUInfo) != "null" {
+		cpuInfo := s.CPUInfo
+		var encoded string
+		if json.Unmarshal(s.CPUInfo, &encoded) == nil {
+			cpuInfo = json.RawMessage(encoded)
+		}
+		if err := json.Unmarshal(cpuInfo, &r.CPUInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Service) UnmarshalJSON(b []byte) error {
+	type tmp Service
+	var s struct {
+		tmp
+		ID json.RawMessage `json:"id"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = Service(s.tmp)
+	r.ID, err = stringID(s.ID)
+	return err
+}
+
+// HypervisorPage stores a page of Hypervisors from a List or ListDetail call.
+type HypervisorPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a HypervisorPage is empty.
+func (page HypervisorPage) IsEmpty() (bool, error) {
+	hypervisors, err := ExtractHypervisors(page)
+	return len(hypervisors) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (page HypervisorPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"hypervisors_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractHypervisors interprets a page of results as a slice of Hypervisors.
+func ExtractHypervisors(r pagination.Page) ([]Hypervisor, error) {
+	return pagination.ExtractItems[Hypervisor](r, "hypervisors")
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as a Hypervisor.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as a Hypervisor.
+func (r GetResult) Extract() (*Hypervisor, error) {
+	var s struct {
+		Hypervisor *Hypervisor `json:"hypervisor"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Hypervisor, err
+}
+
+// Statistics is the sum of the resources of all the hypervisors.
+type Statistics struct {
+	// Count is the number of hypervisors.
+	Count int `json:"count"`
+
+	// The other fields are the sums of the fields of the same name of the
+	// hypervisors.
+	CurrentWorkload    int `json:"current_workload"`
+	DiskAvailableLeast int `json:"disk_available_least"`
+	FreeDiskGB         int `json:"free_disk_gb"`
+	FreeRamMB          int `json:"free_ram_mb"`
+	LocalGB            int `json:"local_gb"`
+	LocalGBUsed        int `json:"local_gb_used"`
+	MemoryMB           int `json:"memory_mb"`
+	MemoryMBUsed       int `json:"memory_mb_used"`
+	RunningVMs         int `json:"running_vms"`
+	VCPUs              int `json:"vcpus"`
+	VCPUsUsed          int `json:"vcpus_used"`
+}
+
+// StatisticsResult is the response from a GetStatistics operation. Call its
+// Extract method to interpret it as Statistics.
+type StatisticsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a StatisticsResult as Statistics.
+func (r StatisticsResult) Extract() (*Statistics, error) {
+	var s struct {
+		Stats *Statistics `json:"hypervisor_statistics"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Stats, err
+}
+
+// Uptime is the uptime of a hypervisor.
+type Uptime struct {
+	// ID is the ID of the hypervisor.
+	ID string `json:"-"`
+
+	// HypervisorHostname is the host name of the hypervisor.
+	HypervisorHostname string `json:"hypervisor_hostname"`
+
+	// State and Status are the state and status of the hypervisor.
+	State  string `json:"state"`
+	Status string `json:"status"`
+
+	// Uptime is the output of the uptime command of the host of the hypervisor.
+	Uptime string `json:"uptime"`
+}
+
+func (r *Uptime) UnmarshalJSON(b []byte) error {
+	type tmp Uptime
+	var s struct {
+		tmp
+		ID json.RawMessage `json:"id"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = Uptime(s.tmp)
+	r.ID, err = stringID(s.ID)
+	return err
+}
+
+// UptimeResult is the response from a GetUptime operation. Call its Extract
+// method to interpret it as an Uptime.
+type UptimeResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an UptimeResult as an Uptime.
+func (r UptimeResult) Extract() (*Uptime, error) {
+	var s struct {
+		Uptime *Uptime `json:"hypervisor"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Uptime, err
+}
+
+// ListServersResult is the response from a ListServers operation. Call its
+// Extract method to interpret it as a slice of Hypervisors.
+type ListServersResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListServersResult as a slice of Hypervisors, with their
+// Servers.
+func (r ListServersResult) Extract() ([]Hypervisor, error) {
+	var s struct {
+		Hypervisors []Hypervisor `json:"hypervisors"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Hypervisors, err
+}
@@ -0,0 +1,2 @@
+// compute_extensions_hypervisors_v2
+package testing
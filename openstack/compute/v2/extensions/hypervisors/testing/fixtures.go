@@ -0,0 +1,329 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/hypervisors"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListDetailOutput is a sample response to a ListDetail call, in the format
+// of microversions before 2.28: integer IDs, and the CPU info as a string.
+const ListDetailOutput = `
+{
+    "hypervisors": [
+        {
+            "cpu_info": "{\"arch\": \"x86_64\", \"model\": \"Nehalem\", \"vendor\": \"Intel\"}",
+            "current_workload": 0,
+            "disk_available_least": 0,
+            "free_disk_gb": 1028,
+            "free_ram_mb": 7680,
+            "host_ip": "192.168.1.135",
+            "hypervisor_hostname": "fake-mini",
+            "hypervisor_type": "fake",
+            "hypervisor_version": 1000,
+            "id": 1,
+            "local_gb": 1028,
+            "local_gb_used": 0,
+            "memory_mb": 8192,
+            "memory_mb_used": 512,
+            "running_vms": 0,
+            "service": {
+                "host": "e6a37ee802d74863ab8b91ade8f12a67",
+                "id": 2,
+                "disabled_reason": null
+            },
+            "state": "up",
+            "status": "enabled",
+            "vcpus": 2,
+            "vcpus_used": 0
+        }
+    ]
+}
+`
+
+// FirstPageOutput is the first page of a paginated List response, in the
+// format of microversion 2.53: UUIDs, and the servers of the hypervisors.
+const FirstPageOutput = `
+{
+    "hypervisors": [
+        {
+            "hypervisor_hostname": "host1",
+            "id": "c48f6247-abe4-4a24-824e-ea39e108874f",
+            "state": "up",
+            "status": "enabled",
+            "servers": [
+                {
+                    "name": "test_server1",
+                    "uuid": "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+                }
+            ]
+        }
+    ],
+    "hypervisors_links": [
+        {
+            "href": "%s/os-hypervisors?limit=1&marker=c48f6247-abe4-4a24-824e-ea39e108874f&with_servers=true",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// SecondPageOutput is the last page of a paginated List response.
+const SecondPageOutput = `
+{
+    "hypervisors": [
+        {
+            "hypervisor_hostname": "host2",
+            "id": "1bb62a04-c576-402c-8147-9e89757a09e3",
+            "state": "up",
+            "status": "disabled"
+        }
+    ]
+}
+`
+
+// GetOutput is a sample response to a Get call, in the format of
+// microversion 2.28 on: the CPU info as an object.
+const GetOutput = `
+{
+    "hypervisor": {
+        "cpu_info": {
+            "arch": "x86_64",
+            "model": "Nehalem",
+            "vendor": "Intel"
+        },
+        "current_workload": 0,
+        "disk_available_least": 0,
+        "free_disk_gb": 1028,
+        "free_ram_mb": 7680,
+        "host_ip": "192.168.1.135",
+        "hypervisor_hostname": "fake-mini",
+        "hypervisor_type": "fake",
+        "hypervisor_version": 1000,
+        "id": 1,
+        "local_gb": 1028,
+        "local_gb_used": 0,
+        "memory_mb": 8192,
+        "memory_mb_used": 512,
+        "running_vms": 0,
+        "service": {
+            "host": "e6a37ee802d74863ab8b91ade8f12a67",
+            "id": 2,
+            "disabled_reason": null
+        },
+        "state": "up",
+        "status": "enabled",
+        "vcpus": 2,
+        "vcpus_used": 0
+    }
+}
+`
+
+// StatisticsOutput is a sample response to a GetStatistics call.
+const StatisticsOutput = `
+{
+    "hypervisor_statistics": {
+        "count": 1,
+        "current_workload": 0,
+        "disk_available_least": 0,
+        "free_disk_gb": 1028,
+        "free_ram_mb": 7680,
+        "local_gb": 1028,
+        "local_gb_used": 0,
+        "memory_mb": 8192,
+        "memory_mb_used": 512,
+        "running_vms": 0,
+        "vcpus": 2,
+        "vcpus_used": 0
+    }
+}
+`
+
+// UptimeOutput is a sample response to a GetUptime call.
+const UptimeOutput = `
+{
+    "hypervisor": {
+        "hypervisor_hostname": "fake-mini",
+        "id": 1,
+        "state": "up",
+        "status": "enabled",
+        "uptime": " 08:32:11 up 93 days, 18:25, 12 users,  load average: 0.20, 0.12, 0.14"
+    }
+}
+`
+
+// ServersOutput is a sample response to a ListServers call.
+const ServersOutput = `
+{
+    "hypervisors": [
+        {
+            "hypervisor_hostname": "fake-mini",
+            "id": 1,
+            "state": "up",
+            "status": "enabled",
+            "servers": [
+                {
+                    "name": "test_server1",
+                    "uuid": "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+                },
+                {
+                    "name": "test_server2",
+                    "uuid": "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+                }
+            ]
+        }
+    ]
+}
+`
+
+// HypervisorFake is the parsed result of ListDetailOutput and GetOutput.
+var HypervisorFake = hypervisors.Hypervisor{
+	ID:                 "1",
+	HypervisorHostname: "fake-mini",
+	State:              "up",
+	Status:             "enabled",
+	HypervisorType:     "fake",
+	HypervisorVersion:  1000,
+	HostIP:             "192.168.1.135",
+	CPUInfo: map[string]interface{}{
+		"arch":   "x86_64",
+		"model":  "Nehalem",
+		"vendor": "Intel",
+	},
+	VCPUs:        2,
+	MemoryMB:     8192,
+	MemoryMBUsed: 512,
+	FreeRamMB:    7680,
+	LocalGB:      1028,
+	FreeDiskGB:   1028,
+	Service: hypervisors.Service{
+		ID:   "2",
+		Host: "e6a37ee802d74863ab8b91ade8f12a67",
+	},
+}
+
+// ExpectedPagedHypervisors are the parsed results of FirstPageOutput and
+// SecondPageOutput.
+var ExpectedPagedHypervisors = []hypervisors.Hypervisor{
+	{
+		ID:                 "c48f6247-abe4-4a24-824e-ea39e108874f",
+		HypervisorHostname: "host1",
+		State:              "up",
+		Status:             "enabled",
+		Servers: []hypervisors.Server{
+			{Name: "test_server1", UUID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"},
+		},
+	},
+	{
+		ID:                 "1bb62a04-c576-402c-8147-9e89757a09e3",
+		HypervisorHostname: "host2",
+		State:              "up",
+		Status:             "disabled",
+	},
+}
+
+// StatisticsFake is the parsed result of StatisticsOutput.
+var StatisticsFake = hypervisors.Statistics{
+	Count:        1,
+	FreeDiskGB:   1028,
+	FreeRamMB:    7680,
+	LocalGB:      1028,
+	MemoryMB:     8192,
+	MemoryMBUsed: 512,
+	VCPUs:        2,
+}
+
+// UptimeFake is the parsed result of UptimeOutput.
+var UptimeFake = hypervisors.Uptime{
+	ID:                 "1",
+	HypervisorHostname: "fake-mini",
+	State:              "up",
+	Status:             "enabled",
+	Uptime:             " 08:32:11 up 93 days, 18:25, 12 users,  load average: 0.20, 0.12, 0.14",
+}
+
+// HandleListDetailSuccessfully configures the test server to respond to a
+// ListDetail request.
+func HandleListDetailSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListDetailOutput)
+	})
+}
+
+// HandleListPagedSuccessfully configures the test server to respond to a
+// List request with two pages, filtered on the servers.
+func HandleListPagedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		th.CheckEquals(t, "1", r.Form.Get("limit"))
+		th.CheckEquals(t, "true", r.Form.Get("with_servers"))
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			fmt.Fprintf(w, FirstPageOutput, th.Server.URL)
+		case "c48f6247-abe4-4a24-824e-ea39e108874f":
+			fmt.Fprint(w, SecondPageOutput)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleStatisticsSuccessfully configures the test server to respond to a
+// GetStatistics request.
+func HandleStatisticsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/statistics", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, StatisticsOutput)
+	})
+}
+
+// HandleUptimeSuccessfully configures the test server to respond to a
+// GetUptime request.
+func HandleUptimeSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/1/uptime", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, UptimeOutput)
+	})
+}
+
+// HandleServersSuccessfully configures the test server to respond to a
+// ListServers request.
+func HandleServersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-hypervisors/fake/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ServersOutput)
+	})
+}
@@ -0,0 +1,87 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/hypervisors"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListDetailSuccessfully(t)
+
+	count := 0
+	err := hypervisors.ListDetail(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := hypervisors.ExtractHypervisors(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []hypervisors.Hypervisor{HypervisorFake}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, count)
+}
+
+func TestListPaged(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListPagedSuccessfully(t)
+
+	withServers := true
+	opts := hypervisors.ListOpts{Limit: 1, WithServers: &withServers}
+	allPages, err := hypervisors.List(client.ServiceClient(), opts).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := hypervisors.ExtractHypervisors(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedPagedHypervisors, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := hypervisors.Get(client.ServiceClient(), "1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &HypervisorFake, actual)
+}
+
+func TestGetStatistics(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleStatisticsSuccessfully(t)
+
+	actual, err := hypervisors.GetStatistics(client.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &StatisticsFake, actual)
+}
+
+func TestGetUptime(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUptimeSuccessfully(t)
+
+	actual, err := hypervisors.GetUptime(client.ServiceClient(), "1").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &UptimeFake, actual)
+}
+
+func TestListServers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServersSuccessfully(t)
+
+	actual, err := hypervisors.ListServers(client.ServiceClient(), "fake").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(actual))
+	th.CheckEquals(t, "1", actual[0].ID)
+	th.CheckDeepEquals(t, []hypervisors.Server{
+		{Name: "test_server1", UUID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"},
+		{Name: "test_server2", UUID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"},
+	}, actual[0].Servers)
+}
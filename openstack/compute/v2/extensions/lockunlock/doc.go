@@ -0,0 +1,5 @@
+/*
+Package lockunlock provides functionality to lock and unlock servers that have
+been provisioned by the OpenStack Compute service.
+*/
+package lockunlock
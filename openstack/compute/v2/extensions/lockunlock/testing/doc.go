@@ -0,0 +1,2 @@
+// compute_extensions_lockunlock_v2
+package testing
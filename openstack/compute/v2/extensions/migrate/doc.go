@@ -0,0 +1,6 @@
+/*
+Package migrate provides functionality to migrate servers that have been
+provisioned by the OpenStack Compute service to another host, either cold,
+stopping them, or live. It requires administrative privileges.
+*/
+package migrate
@@ -0,0 +1,77 @@
+package migrate
+
+import "github.com/gophercloud/gophercloud"
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Migrate is the operation responsible for cold migrating a Compute server to
+// a host picked by the scheduler. The migration is confirmed, or reverted,
+// with servers.ConfirmResize or servers.RevertResize.
+func Migrate(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"migrate": nil}, nil, nil)
+	return
+}
+
+// LiveMigrateOptsBuilder allows extensions to add additional parameters to the
+// LiveMigrate request.
+type LiveMigrateOptsBuilder interface {
+	ToLiveMigrateMap() (map[string]interface{}, error)
+}
+
+// LiveMigrateOpts specifies parameters of a LiveMigrate request.
+type LiveMigrateOpts struct {
+	// Host is the host the server is migrated to. When nil, the scheduler picks
+	// one.
+	Host *string `json:"host"`
+
+	// BlockMigration migrates the disks of the server along with it, rather
+	// than relying on shared storage. When nil, Nova decides from microversion
+	// 2.25, and it defaults to false before.
+	BlockMigration *bool `json:"block_migration,omitempty"`
+
+	// DiskOverCommit allows the disks of the server to be overcommitted on the
+	// destination host. It's only accepted before microversion 2.25, and
+	// defaults to false there.
+	DiskOverCommit *bool `json:"disk_over_commit,omitempty"`
+
+	// Force bypasses the checks of the scheduler on Host. It requires
+	// microversion 2.30, and is no longer accepted from 2.68.
+	Force *bool `json:"force,omitempty"`
+}
+
+// ToLiveMigrateMap builds a request body from LiveMigrateOpts.
+func (opts LiveMigrateOpts) ToLiveMigrateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "os-migrateLive")
+}
+
+// LiveMigrate is the operation responsible for live migrating a Compute server
+// to another host, while it keeps running. The options Nova requires, and that
+// are left unset, are filled in for the microversion of the client.
+func LiveMigrate(client *gophercloud.ServiceClient, id string, opts LiveMigrateOptsBuilder) (r gophercloud.ErrResult) {
+	b, err := opts.ToLiveMigrateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	if m, ok := b["os-migrateLive"].(map[string]interface{}); ok {
+		if client.MicroversionAtLeast("2.25") {
+			setDefault(m, "block_migration", "auto")
+		} else {
+			setDefault(m, "block_migration", false)
+			setDefault(m, "disk_over_commit", false)
+		}
+	}
+
+	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	return
+}
+
+// setDefault sets the value of key in m, unless it's already set.
+func setDefault(m map[string]interface{}, key string, value interface{}) {
+	if _, ok := m[key]; !ok {
+		m[key] = value
+	}
+}
@@ -0,0 +1,2 @@
+// compute_extensions_migrate_v2
+package testing
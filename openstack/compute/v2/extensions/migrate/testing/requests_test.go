@@ -0,0 +1,79 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/migrate"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestMigrate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockMigrateResponse(t, serverID)
+
+	err := migrate.Migrate(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestLiveMigrate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockLiveMigrateResponse(t, serverID, `{
+		"os-migrateLive": {
+			"host": "compute-02",
+			"block_migration": true,
+			"disk_over_commit": false,
+			"force": true
+		}
+	}`)
+
+	host := "compute-02"
+	blockMigration := true
+	force := true
+	opts := migrate.LiveMigrateOpts{
+		Host:           &host,
+		BlockMigration: &blockMigration,
+		Force:          &force,
+	}
+	err := migrate.LiveMigrate(client.ServiceClient(), serverID, opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestLiveMigrateScheduled(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockLiveMigrateResponse(t, serverID, `{
+		"os-migrateLive": {
+			"host": null,
+			"block_migration": "auto"
+		}
+	}`)
+
+	c := client.ServiceClient()
+	c.Microversion = "2.25"
+	err := migrate.LiveMigrate(c, serverID, migrate.LiveMigrateOpts{}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestLiveMigrateScheduledBeforeMicroversion225(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockLiveMigrateResponse(t, serverID, `{
+		"os-migrateLive": {
+			"host": null,
+			"block_migration": false,
+			"disk_over_commit": false
+		}
+	}`)
+
+	err := migrate.LiveMigrate(client.ServiceClient(), serverID, migrate.LiveMigrateOpts{}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,5 @@
+/*
+Package pauseunpause provides functionality to pause and unpause servers that have
+been provisioned by the OpenStack Compute service.
+*/
+package pauseunpause
@@ -0,0 +1,2 @@
+// compute_extensions_pauseunpause_v2
+package testing
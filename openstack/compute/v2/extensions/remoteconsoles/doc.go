@@ -0,0 +1,35 @@
+/*
+Package remoteconsoles provides functionality to create the URLs of the remote
+consoles, like noVNC or SPICE, of servers that have been provisioned by the
+OpenStack Compute service.
+
+Create uses the remote consoles API of microversion 2.6 and later, which the
+client must request, while CreateLegacy uses the server actions of earlier
+microversions.
+
+Example to Create a noVNC Console URL
+
+	computeClient.Microversion = "2.6"
+
+	createOpts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}
+
+	console, err := remoteconsoles.Create(computeClient, "server-id", createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(console.URL)
+
+Example to Create a Serial Console URL with a Legacy Action
+
+	console, err := remoteconsoles.CreateLegacy(computeClient, "server-id", remoteconsoles.ConsoleTypeSerial).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(console.URL)
+*/
+package remoteconsoles
@@ -0,0 +1,115 @@
+package remoteconsoles
+
+import "github.com/gophercloud/gophercloud"
+
+// ConsoleProtocol is the protocol of a remote console.
+type ConsoleProtocol string
+
+const (
+	// ConsoleProtocolVNC is the protocol of VNC consoles.
+	ConsoleProtocolVNC ConsoleProtocol = "vnc"
+
+	// ConsoleProtocolSPICE is the protocol of SPICE consoles.
+	ConsoleProtocolSPICE ConsoleProtocol = "spice"
+
+	// ConsoleProtocolSerial is the protocol of serial consoles.
+	ConsoleProtocolSerial ConsoleProtocol = "serial"
+
+	// ConsoleProtocolRDP is the protocol of RDP consoles.
+	ConsoleProtocolRDP ConsoleProtocol = "rdp"
+
+	// ConsoleProtocolMKS is the protocol of MKS consoles, from microversion
+	// 2.8 on.
+	ConsoleProtocolMKS ConsoleProtocol = "mks"
+)
+
+// ConsoleType is the type of client a remote console is created for.
+type ConsoleType string
+
+const (
+	// ConsoleTypeNoVNC is the type of VNC consoles used through noVNC.
+	ConsoleTypeNoVNC ConsoleType = "novnc"
+
+	// ConsoleTypeXVPVNC is the type of VNC consoles used through an XVP VNC
+	// client.
+	ConsoleTypeXVPVNC ConsoleType = "xvpvnc"
+
+	// ConsoleTypeSPICEHTML5 is the type of SPICE consoles used through the
+	// SPICE HTML5 client.
+	ConsoleTypeSPICEHTML5 ConsoleType = "spice-html5"
+
+	// ConsoleTypeSerial is the type of serial consoles.
+	ConsoleTypeSerial ConsoleType = "serial"
+
+	// ConsoleTypeRDPHTML5 is the type of RDP consoles used through an HTML5
+	// client.
+	ConsoleTypeRDPHTML5 ConsoleType = "rdp-html5"
+
+	// ConsoleTypeWebMKS is the type of MKS consoles.
+	ConsoleTypeWebMKS ConsoleType = "webmks"
+)
+
+// legacyActions are the server actions creating the consoles of each type
+// before microversion 2.6.
+var legacyActions = map[ConsoleType]string{
+	ConsoleTypeNoVNC:      "os-getVNCConsole",
+	ConsoleTypeXVPVNC:     "os-getVNCConsole",
+	ConsoleTypeSPICEHTML5: "os-getSPICEConsole",
+	ConsoleTypeSerial:     "os-getSerialConsole",
+	ConsoleTypeRDPHTML5:   "os-getRDPConsole",
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToRemoteConsoleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts specifies parameters to the Create request.
+type CreateOpts struct {
+	// Protocol is the protocol of the remote console.
+	Protocol ConsoleProtocol `json:"protocol" required:"true"`
+
+	// Type is the type of the remote console, which must match its protocol.
+	Type ConsoleType `json:"type" required:"true"`
+}
+
+// ToRemoteConsoleCreateMap builds a request body from the CreateOpts.
+func (opts CreateOpts) ToRemoteConsoleCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "remote_console")
+}
+
+// Create requests the creation of a remote console for a server. It requires
+// microversion 2.6.
+func Create(client *gophercloud.ServiceClient, serverID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRemoteConsoleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, serverID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// CreateLegacy requests the creation of a remote console of the given type
+// for a server, with the server action of microversions earlier than 2.6.
+func CreateLegacy(client *gophercloud.ServiceClient, serverID string, consoleType ConsoleType) (r CreateLegacyResult) {
+	action, ok := legacyActions[consoleType]
+	if !ok {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "consoleType"
+		err.Value = consoleType
+		r.Err = err
+		return
+	}
+
+	b := map[string]interface{}{
+		action: map[string]interface{}{"type": consoleType},
+	}
+	_, r.Err = client.Post(actionURL(client, serverID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,60 @@
+package remoteconsoles
+
+import "github.com/gophercloud/gophercloud"
+
+// RemoteConsole represents the remote console of a server.
+type RemoteConsole struct {
+	// Protocol is the protocol of the remote console. It's not returned by the
+	// legacy actions, but derived from Type.
+	Protocol ConsoleProtocol `json:"protocol"`
+
+	// Type is the type of the remote console.
+	Type ConsoleType `json:"type"`
+
+	// URL is the URL used to connect to the remote console.
+	URL string `json:"url"`
+}
+
+// legacyProtocols are the protocols of the consoles of each type.
+var legacyProtocols = map[ConsoleType]ConsoleProtocol{
+	ConsoleTypeNoVNC:      ConsoleProtocolVNC,
+	ConsoleTypeXVPVNC:     ConsoleProtocolVNC,
+	ConsoleTypeSPICEHTML5: ConsoleProtocolSPICE,
+	ConsoleTypeSerial:     ConsoleProtocolSerial,
+	ConsoleTypeRDPHTML5:   ConsoleProtocolRDP,
+}
+
+// CreateResult represents the result of a Create operation. Call its Extract
+// method to interpret it as a RemoteConsole.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateResult as a RemoteConsole.
+func (r CreateResult) Extract() (*RemoteConsole, error) {
+	var s struct {
+		RemoteConsole *RemoteConsole `json:"remote_console"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RemoteConsole, err
+}
+
+// CreateLegacyResult represents the result of a CreateLegacy operation. Call
+// its Extract method to interpret it as a RemoteConsole.
+type CreateLegacyResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateLegacyResult as a RemoteConsole.
+func (r CreateLegacyResult) Extract() (*RemoteConsole, error) {
+	var s struct {
+		Console *RemoteConsole `json:"console"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil || s.Console == nil {
+		return s.Console, err
+	}
+
+	s.Console.Protocol = legacyProtocols[s.Console.Type]
+	return s.Console, nil
+}
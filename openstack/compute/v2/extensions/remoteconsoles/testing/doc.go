@@ -0,0 +1,2 @@
+// compute_extensions_remoteconsoles_v2
+package testing
@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// RemoteConsoleRequest is a sample request to create a remote console.
+const RemoteConsoleRequest = `
+{
+    "remote_console": {
+        "protocol": "vnc",
+        "type": "novnc"
+    }
+}
+`
+
+// RemoteConsoleResponse is a sample response to a request to create a remote
+// console.
+const RemoteConsoleResponse = `
+{
+    "remote_console": {
+        "protocol": "vnc",
+        "type": "novnc",
+        "url": "http://192.168.0.4:6080/vnc_auto.html?path=%3Ftoken%3D9a2ec4ad"
+    }
+}
+`
+
+// LegacySerialConsoleRequest is a sample request to create a serial console
+// with a legacy action.
+const LegacySerialConsoleRequest = `
+{
+    "os-getSerialConsole": {
+        "type": "serial"
+    }
+}
+`
+
+// LegacySerialConsoleResponse is a sample response to a request to create a
+// serial console with a legacy action.
+const LegacySerialConsoleResponse = `
+{
+    "console": {
+        "type": "serial",
+        "url": "ws://127.0.0.1:6083/?token=f9906a48"
+    }
+}
+`
+
+func mockCreateResponse(t *testing.T, id string) {
+	th.Mux.HandleFunc("/servers/"+id+"/remote-consoles", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, RemoteConsoleRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, RemoteConsoleResponse)
+	})
+}
+
+func mockCreateLegacyResponse(t *testing.T, id string) {
+	th.Mux.HandleFunc("/servers/"+id+"/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, LegacySerialConsoleRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, LegacySerialConsoleResponse)
+	})
+}
@@ -0,0 +1,64 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/remoteconsoles"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockCreateResponse(t, serverID)
+
+	opts := remoteconsoles.CreateOpts{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+	}
+	actual, err := remoteconsoles.Create(client.ServiceClient(), serverID, opts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &remoteconsoles.RemoteConsole{
+		Protocol: remoteconsoles.ConsoleProtocolVNC,
+		Type:     remoteconsoles.ConsoleTypeNoVNC,
+		URL:      "http://192.168.0.4:6080/vnc_auto.html?path=%3Ftoken%3D9a2ec4ad",
+	}
+	th.AssertDeepEquals(t, expected, actual)
+}
+
+func TestCreateMissingType(t *testing.T) {
+	opts := remoteconsoles.CreateOpts{Protocol: remoteconsoles.ConsoleProtocolVNC}
+	res := remoteconsoles.Create(client.ServiceClient(), serverID, opts)
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a missing type")
+	}
+}
+
+func TestCreateLegacy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockCreateLegacyResponse(t, serverID)
+
+	actual, err := remoteconsoles.CreateLegacy(client.ServiceClient(), serverID, remoteconsoles.ConsoleTypeSerial).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &remoteconsoles.RemoteConsole{
+		Protocol: remoteconsoles.ConsoleProtocolSerial,
+		Type:     remoteconsoles.ConsoleTypeSerial,
+		URL:      "ws://127.0.0.1:6083/?token=f9906a48",
+	}
+	th.AssertDeepEquals(t, expected, actual)
+}
+
+func TestCreateLegacyUnsupportedType(t *testing.T) {
+	res := remoteconsoles.CreateLegacy(client.ServiceClient(), serverID, remoteconsoles.ConsoleTypeWebMKS)
+	if res.Err == nil {
+		t.Fatalf("Expected an error for a console type without legacy action")
+	}
+}
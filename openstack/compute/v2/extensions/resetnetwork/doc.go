@@ -0,0 +1,5 @@
+/*
+Package resetnetwork provides functionality to reset the networking of servers that have
+been provisioned by the OpenStack Compute service.
+*/
+package resetnetwork
@@ -0,0 +1,14 @@
+package resetnetwork
+
+import "github.com/gophercloud/gophercloud"
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// ResetNetwork is the operation responsible for resetting the networking of a
+// Compute server. It requires administrative privileges.
+func ResetNetwork(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"resetNetwork": nil}, nil, nil)
+	return
+}
@@ -0,0 +1,2 @@
+// compute_extensions_resetnetwork_v2
+package testing
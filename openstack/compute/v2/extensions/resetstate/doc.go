@@ -0,0 +1,6 @@
+/*
+Package resetstate provides functionality to reset the state of servers that
+have been provisioned by the OpenStack Compute service, when they're stuck in
+an error or transitional state. It requires administrative privileges.
+*/
+package resetstate
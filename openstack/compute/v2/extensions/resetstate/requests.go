@@ -0,0 +1,28 @@
+package resetstate
+
+import "github.com/gophercloud/gophercloud"
+
+// ServerState is the state a server is reset to.
+type ServerState string
+
+const (
+	// StateActive is the state of a running server.
+	StateActive ServerState = "active"
+
+	// StateError is the state of a server in error.
+	StateError ServerState = "error"
+)
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// ResetState is the operation responsible for resetting the state of a
+// Compute server, without acting on the server itself.
+func ResetState(client *gophercloud.ServiceClient, id string, state ServerState) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"os-resetState": map[string]interface{}{"state": state},
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	return
+}
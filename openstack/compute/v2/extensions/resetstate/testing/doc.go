@@ -0,0 +1,2 @@
+// compute_extensions_resetstate_v2
+package testing
@@ -0,0 +1,64 @@
+/*
+Package services manages the services of the OpenStack Compute service, like
+nova-compute or nova-scheduler, on each of their hosts. It requires
+administrative privileges.
+
+Before microversion 2.53, services are enabled, disabled and forced down by
+host and binary name, with Enable, Disable and ForceDown. From microversion
+2.53 on, they are updated by ID with Update.
+
+Example to List Services
+
+	listOpts := services.ListOpts{
+		Binary: "nova-compute",
+	}
+
+	allPages, err := services.List(computeClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allServices, err := services.ExtractServices(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, service := range allServices {
+		fmt.Printf("%s on %s: %s, %s\n", service.Binary, service.Host, service.Status, service.State)
+	}
+
+Example to Disable a Service
+
+	disableOpts := services.DisableOpts{
+		Host:           "compute1",
+		Binary:         "nova-compute",
+		DisabledReason: "maintenance",
+	}
+
+	service, err := services.Disable(computeClient, disableOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Force a Service Down with Microversion 2.53
+
+	computeClient.Microversion = "2.53"
+
+	forcedDown := true
+	updateOpts := services.UpdateOpts{
+		ForcedDown: &forcedDown,
+	}
+
+	service, err := services.Update(computeClient, serviceID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Service
+
+	err := services.Delete(computeClient, serviceID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package services
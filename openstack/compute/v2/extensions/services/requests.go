@@ -0,0 +1,156 @@
+package services
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ServiceStatus is whether a service is enabled.
+type ServiceStatus string
+
+const (
+	StatusEnabled  ServiceStatus = "enabled"
+	StatusDisabled ServiceStatus = "disabled"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToServiceListQuery() (string, error)
+}
+
+// ListOpts allows to filter the services listed.
+type ListOpts struct {
+	// Binary only lists the services of this binary, like "nova-compute".
+	Binary string `q:"binary"`
+
+	// Host only lists the services of this host.
+	Host string `q:"host"`
+}
+
+// ToServiceListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToServiceListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager that allows you to iterate over the services.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToServiceListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ServicePage{pagination.SinglePageBase(r)}
+	})
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToServiceUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a service to update. It requires
+// microversion 2.53.
+type UpdateOpts struct {
+	// Status enables or disables the service.
+	Status ServiceStatus `json:"status,omitempty"`
+
+	// DisabledReason is the reason the service is disabled for. It requires
+	// Status to be StatusDisabled.
+	DisabledReason string `json:"disabled_reason,omitempty"`
+
+	// ForcedDown forces the service down, or stops forcing it down.
+	ForcedDown *bool `json:"forced_down,omitempty"`
+}
+
+// ToServiceUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToServiceUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update enables, disables or forces down a service by ID. It requires
+// microversion 2.53.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToServiceUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Enable enables the service of binary on host. It's superseded by Update
+// from microversion 2.53 on.
+func Enable(client *gophercloud.ServiceClient, host, binary string) (r UpdateResult) {
+	b := map[string]interface{}{"host": host, "binary": binary}
+	_, r.Err = client.Put(legacyActionURL(client, "enable"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DisableOptsBuilder allows extensions to add additional parameters to the
+// Disable request.
+type DisableOptsBuilder interface {
+	ToServiceDisableMap() (map[string]interface{}, error)
+}
+
+// DisableOpts specifies the service to disable.
+type DisableOpts struct {
+	// Host and Binary identify the service.
+	Host   string `json:"host" required:"true"`
+	Binary string `json:"binary" required:"true"`
+
+	// DisabledReason is the reason the service is disabled for.
+	DisabledReason string `json:"disabled_reason,omitempty"`
+}
+
+// ToServiceDisableMap constructs a request body from DisableOpts.
+func (opts DisableOpts) ToServiceDisableMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Disable disables a service, and logs the reason if any. It's superseded by
+// Update from microversion 2.53 on.
+func Disable(client *gophercloud.ServiceClient, opts DisableOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToServiceDisableMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	action := "disable"
+	if _, ok := b["disabled_reason"]; ok {
+		action = "disable-log-reason"
+	}
+	_, r.Err = client.Put(legacyActionURL(client, action), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ForceDown forces the service of binary on host down, or stops forcing it
+// down. It requires microversion 2.11, and is superseded by Update from
+// microversion 2.53 on.
+func ForceDown(client *gophercloud.ServiceClient, host, binary string, forcedDown bool) (r UpdateResult) {
+	b := map[string]interface{}{"host": host, "binary": binary, "forced_down": forcedDown}
+	_, r.Err = client.Put(legacyActionURL(client, "force-down"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a service. Deleting a nova-compute service also deletes its
+// hypervisor, and fails while servers run on it.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Service is a service of the Compute service on a host.
+type Service struct {
+	// ID is the ID of the service: an integer before microversion 2.53, a UUID
+	// from it on.
+	ID string `json:"-"`
+
+	// Binary is the binary of the service, like "nova-compute".
+	Binary string `json:"binary"`
+
+	// Host is the host of the service.
+	Host string `json:"host"`
+
+	// Zone is the availability zone of the service.
+	Zone string `json:"zone"`
+
+	// Status is whether the service is enabled.
+	Status ServiceStatus `json:"status"`
+
+	// State is whether the service is "up" or "down".
+	State string `json:"state"`
+
+	// DisabledReason is the reason the service was disabled for.
+	DisabledReason string `json:"disabled_reason"`
+
+	// ForcedDown is whether the service was forced down.
+	ForcedDown bool `json:"forced_down"`
+
+	// UpdatedAt is when the service last reported its state.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// stringID converts an ID, a number or a string, to a string.
+func stringID(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	var id interface{}
+	if err := json.Unmarshal(raw, &id); err != nil {
+		return "", err
+	}
+	switch v := id.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return fmt.Sprintf("%d", int64(v)), nil
+	}
+	return "", fmt.Errorf("Unexpected ID: %s", raw)
+}
+
+func (r *Service) UnmarshalJSON(b []byte) error {
+	type tmp Service
+	var s struct {
+		tmp
+		ID        json.RawMessage                 `json:"id"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Service(s.tmp)
+
+	r.ID, err = stringID(s.ID)
+	if err != nil {
+		return err
+	}
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// ServicePage stores a single, only page of Services from a List call.
+type ServicePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a ServicePage is empty.
+func (page ServicePage) IsEmpty() (bool, error) {
+	services, err := ExtractServices(page)
+	return len(services) == 0, err
+}
+
+// ExtractServices interprets a page of results as a slice of Services.
+func ExtractServices(r pagination.Page) ([]Service, error) {
+	var s struct {
+		Services []Service `json:"services"`
+	}
+	err := (r.(ServicePage)).ExtractInto(&s)
+	return s.Services, err
+}
+
+// UpdateResult is the response from an Update, Enable, Disable or ForceDown
+// operation. Call its Extract method to interpret it as a Service. Before
+// microversion 2.53, only the fields changed and the Host and Binary are set.
+type UpdateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an UpdateResult as a Service.
+func (r UpdateResult) Extract() (*Service, error) {
+	var s struct {
+		Service *Service `json:"service"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Service, err
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
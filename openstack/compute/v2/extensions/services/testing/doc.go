@@ -0,0 +1,2 @@
+// compute_extensions_services_v2
+package testing
@@ -0,0 +1,148 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/services"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput is a sample response to a List call, in the format of
+// microversions before 2.53.
+const ListOutput = `
+{
+    "services": [
+        {
+            "id": 1,
+            "binary": "nova-scheduler",
+            "disabled_reason": "test1",
+            "host": "host1",
+            "state": "up",
+            "status": "disabled",
+            "updated_at": "2012-10-29T13:42:02.000000",
+            "forced_down": false,
+            "zone": "internal"
+        },
+        {
+            "id": 2,
+            "binary": "nova-compute",
+            "disabled_reason": null,
+            "host": "host1",
+            "state": "up",
+            "status": "enabled",
+            "updated_at": "2012-10-29T13:42:05.000000",
+            "forced_down": false,
+            "zone": "nova"
+        }
+    ]
+}
+`
+
+// UpdateOutput is a sample response to an Update call, in the format of
+// microversion 2.53.
+const UpdateOutput = `
+{
+    "service": {
+        "id": "e81d66a4-ddd3-4aba-8a84-171d1cb4d339",
+        "binary": "nova-compute",
+        "disabled_reason": "maintenance",
+        "host": "host1",
+        "state": "down",
+        "status": "disabled",
+        "updated_at": "2012-10-29T13:42:05.000000",
+        "forced_down": true,
+        "zone": "nova"
+    }
+}
+`
+
+// ExpectedServices is the parsed result of ListOutput.
+var ExpectedServices = []services.Service{
+	{
+		ID:             "1",
+		Binary:         "nova-scheduler",
+		DisabledReason: "test1",
+		Host:           "host1",
+		State:          "up",
+		Status:         services.StatusDisabled,
+		UpdatedAt:      time.Date(2012, 10, 29, 13, 42, 2, 0, time.UTC),
+		Zone:           "internal",
+	},
+	{
+		ID:        "2",
+		Binary:    "nova-compute",
+		Host:      "host1",
+		State:     "up",
+		Status:    services.StatusEnabled,
+		UpdatedAt: time.Date(2012, 10, 29, 13, 42, 5, 0, time.UTC),
+		Zone:      "nova",
+	},
+}
+
+// UpdatedService is the parsed result of UpdateOutput.
+var UpdatedService = services.Service{
+	ID:             "e81d66a4-ddd3-4aba-8a84-171d1cb4d339",
+	Binary:         "nova-compute",
+	DisabledReason: "maintenance",
+	Host:           "host1",
+	State:          "down",
+	Status:         services.StatusDisabled,
+	ForcedDown:     true,
+	UpdatedAt:      time.Date(2012, 10, 29, 13, 42, 5, 0, time.UTC),
+	Zone:           "nova",
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request filtered on the binary.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-services", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"host": "host1"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListOutput)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-services/e81d66a4-ddd3-4aba-8a84-171d1cb4d339", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"status": "disabled", "disabled_reason": "maintenance", "forced_down": true}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+// HandleLegacyActionSuccessfully configures the test server to respond to an
+// Enable, Disable or ForceDown request with the given action and body, and
+// echo the body back as the service.
+func HandleLegacyActionSuccessfully(t *testing.T, action, body string) {
+	th.Mux.HandleFunc("/os-services/"+action, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, body)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"service": %s}`, body)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a Delete
+// request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-services/e81d66a4-ddd3-4aba-8a84-171d1cb4d339", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,101 @@
+package testing
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/services"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	allPages, err := services.List(client.ServiceClient(), services.ListOpts{Host: "host1"}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := services.ExtractServices(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedServices, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	forcedDown := true
+	opts := services.UpdateOpts{
+		Status:         services.StatusDisabled,
+		DisabledReason: "maintenance",
+		ForcedDown:     &forcedDown,
+	}
+	actual, err := services.Update(client.ServiceClient(), "e81d66a4-ddd3-4aba-8a84-171d1cb4d339", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &UpdatedService, actual)
+}
+
+func TestEnable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLegacyActionSuccessfully(t, "enable", `{"host": "host1", "binary": "nova-compute"}`)
+
+	actual, err := services.Enable(client.ServiceClient(), "host1", "nova-compute").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "host1", actual.Host)
+}
+
+func TestDisable(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLegacyActionSuccessfully(t, "disable", `{"host": "host1", "binary": "nova-compute"}`)
+
+	opts := services.DisableOpts{Host: "host1", Binary: "nova-compute"}
+	err := services.Disable(client.ServiceClient(), opts).Err
+	th.AssertNoErr(t, err)
+}
+
+func TestDisableLogReason(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLegacyActionSuccessfully(t, "disable-log-reason", `{"host": "host1", "binary": "nova-compute", "disabled_reason": "maintenance"}`)
+
+	opts := services.DisableOpts{Host: "host1", Binary: "nova-compute", DisabledReason: "maintenance"}
+	actual, err := services.Disable(client.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "maintenance", actual.DisabledReason)
+}
+
+func TestForceDown(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleLegacyActionSuccessfully(t, "force-down", `{"host": "host1", "binary": "nova-compute", "forced_down": true}`)
+
+	actual, err := services.ForceDown(client.ServiceClient(), "host1", "nova-compute", true).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, actual.ForcedDown)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := services.Delete(client.ServiceClient(), "e81d66a4-ddd3-4aba-8a84-171d1cb4d339").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestServiceID(t *testing.T) {
+	var s services.Service
+	th.AssertNoErr(t, json.Unmarshal([]byte(`{"id": 1}`), &s))
+	th.CheckEquals(t, "1", s.ID)
+
+	th.AssertNoErr(t, json.Unmarshal([]byte(`{"id": "e81d66a4-ddd3-4aba-8a84-171d1cb4d339"}`), &s))
+	th.CheckEquals(t, "e81d66a4-ddd3-4aba-8a84-171d1cb4d339", s.ID)
+
+	if err := json.Unmarshal([]byte(`{"id": true}`), &s); err == nil {
+		t.Fatalf("Expected an error for an unexpected ID")
+	}
+}
@@ -0,0 +1,21 @@
+package services
+
+import "github.com/gophercloud/gophercloud"
+
+const resourcePath = "os-services"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return updateURL(c, id)
+}
+
+func legacyActionURL(c *gophercloud.ServiceClient, action string) string {
+	return c.ServiceURL(resourcePath, action)
+}
@@ -0,0 +1,9 @@
+/*
+Package shelveunshelve provides functionality to shelve and unshelve servers
+that have been provisioned by the OpenStack Compute service.
+
+A shelved server is stopped, and an image of it is kept so that it can be
+unshelved later. Shelved servers are offloaded from their host after a delay
+configured in the cloud, or as soon as ShelveOffload is called.
+*/
+package shelveunshelve
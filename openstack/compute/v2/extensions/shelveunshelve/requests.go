@@ -0,0 +1,58 @@
+package shelveunshelve
+
+import "github.com/gophercloud/gophercloud"
+
+func actionURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("servers", id, "action")
+}
+
+// Shelve is the operation responsible for shelving a Compute server.
+func Shelve(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"shelve": nil}, nil, nil)
+	return
+}
+
+// ShelveOffload is the operation responsible for offloading a shelved Compute
+// server from its host, freeing the resources it holds there.
+func ShelveOffload(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Post(actionURL(client, id), map[string]interface{}{"shelveOffload": nil}, nil, nil)
+	return
+}
+
+// UnshelveOptsBuilder allows extensions to add additional parameters to the
+// Unshelve request.
+type UnshelveOptsBuilder interface {
+	ToUnshelveMap() (map[string]interface{}, error)
+}
+
+// UnshelveOpts specifies parameters of an Unshelve request.
+type UnshelveOpts struct {
+	// AvailabilityZone is the availability zone the offloaded server is
+	// unshelved in. It requires microversion 2.77.
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+}
+
+// ToUnshelveMap builds a request body from UnshelveOpts. Without an
+// availability zone, it builds the body of earlier microversions.
+func (opts UnshelveOpts) ToUnshelveMap() (map[string]interface{}, error) {
+	if opts.AvailabilityZone == "" {
+		return map[string]interface{}{"unshelve": nil}, nil
+	}
+	return gophercloud.BuildRequestBody(opts, "unshelve")
+}
+
+// Unshelve is the operation responsible for unshelving a Compute server. When
+// opts is nil, the server is unshelved wherever the scheduler places it.
+func Unshelve(client *gophercloud.ServiceClient, id string, opts UnshelveOptsBuilder) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{"unshelve": nil}
+	if opts != nil {
+		var err error
+		b, err = opts.ToUnshelveMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+	}
+	_, r.Err = client.Post(actionURL(client, id), b, nil, nil)
+	return
+}
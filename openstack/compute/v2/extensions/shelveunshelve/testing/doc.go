@@ -0,0 +1,2 @@
+// compute_extensions_shelveunshelve_v2
+package testing
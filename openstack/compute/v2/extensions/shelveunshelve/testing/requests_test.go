@@ -0,0 +1,52 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/shelveunshelve"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+const serverID = "{serverId}"
+
+func TestShelve(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockShelveResponse(t, serverID)
+
+	err := shelveunshelve.Shelve(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestShelveOffload(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockShelveOffloadResponse(t, serverID)
+
+	err := shelveunshelve.ShelveOffload(client.ServiceClient(), serverID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUnshelve(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockUnshelveResponse(t, serverID, `{"unshelve": null}`)
+
+	err := shelveunshelve.Unshelve(client.ServiceClient(), serverID, nil).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUnshelveWithAvailabilityZone(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	mockUnshelveResponse(t, serverID, `{"unshelve": {"availability_zone": "nova"}}`)
+
+	opts := shelveunshelve.UnshelveOpts{AvailabilityZone: "nova"}
+	err := shelveunshelve.Unshelve(client.ServiceClient(), serverID, opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
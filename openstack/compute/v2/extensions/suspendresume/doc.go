@@ -0,0 +1,5 @@
+/*
+Package suspendresume provides functionality to suspend and resume servers that have
+been provisioned by the OpenStack Compute service.
+*/
+package suspendresume
@@ -0,0 +1,2 @@
+// compute_extensions_suspendresume_v2
+package testing
@@ -4,4 +4,65 @@
 // A flavor is an available hardware configuration for a server. Each flavor
 // has a unique combination of disk space, memory capacity and priority for CPU
 // time.
+//
+// Creating and deleting flavors, and managing their extra specs and access,
+// requires administrative privileges.
+//
+// Example to Create a Private Flavor
+//
+//	disk := 20
+//	isPublic := false
+//	createOpts := flavors.CreateOpts{
+//		Name:     "m1.numa",
+//		RAM:      4096,
+//		VCPUs:    4,
+//		Disk:     &disk,
+//		IsPublic: &isPublic,
+//	}
+//
+//	flavor, err := flavors.Create(computeClient, createOpts).Extract()
+//	if err != nil {
+//		panic(err)
+//	}
+//
+// Example to Grant a Tenant Access to a Private Flavor
+//
+//	accessOpts := flavors.AddAccessOpts{
+//		Tenant: "15153a0979884b59b0592248ef947921",
+//	}
+//
+//	accessList, err := flavors.AddAccess(computeClient, flavor.ID, accessOpts).Extract()
+//	if err != nil {
+//		panic(err)
+//	}
+//
+// Example to Create Extra Specs for a Flavor
+//
+//	createOpts := flavors.ExtraSpecsOpts{
+//		"hw:numa_nodes":     "2",
+//		"hw:mem_page_size": "large",
+//	}
+//
+//	extraSpecs, err := flavors.CreateExtraSpecs(computeClient, flavor.ID, createOpts).Extract()
+//	if err != nil {
+//		panic(err)
+//	}
+//
+// Example to Update an Extra Spec of a Flavor
+//
+//	updateOpts := flavors.ExtraSpecsOpts{
+//		"hw:numa_nodes": "1",
+//	}
+//
+//	extraSpec, err := flavors.UpdateExtraSpec(computeClient, flavor.ID, updateOpts).Extract()
+//	if err != nil {
+//		panic(err)
+//	}
+//
+// Example to Delete a Flavor
+//
+//	err := flavors.Delete(computeClient, flavor.ID).ExtractErr()
+//	if err != nil {
+//		panic(err)
+//	}
 package flavors
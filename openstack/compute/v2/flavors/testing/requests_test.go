@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -132,3 +133,276 @@ func TestGetFlavor(t *testing.T) {
 		t.Errorf("Expected %#v, but was %#v", expected, actual)
 	}
 }
+
+func TestGetFlavorExtendedFields(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors/12345", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"flavor": {
+					"id": "12345",
+					"name": "m1.numa",
+					"disk": 20,
+					"ram": 4096,
+					"vcpus": 4,
+					"swap": 512,
+					"os-flavor-access:is_public": false,
+					"OS-FLV-EXT-DATA:ephemeral": 10,
+					"description": "NUMA flavor"
+				}
+			}
+		`)
+	})
+
+	actual, err := flavors.Get(fake.ServiceClient(), "12345").Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &flavors.Flavor{
+		ID:          "12345",
+		Name:        "m1.numa",
+		Disk:        20,
+		RAM:         4096,
+		VCPUs:       4,
+		Swap:        512,
+		IsPublic:    false,
+		Ephemeral:   10,
+		Description: "NUMA flavor",
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestListFlavorsAccessType(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"is_public": "None"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"flavors": []}`)
+	})
+
+	allPages, err := flavors.ListDetail(fake.ServiceClient(), flavors.ListOpts{AccessType: flavors.AllAccess}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := flavors.ExtractFlavors(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(actual))
+}
+
+func TestCreateFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"flavor": {
+					"name": "m1.numa",
+					"ram": 4096,
+					"vcpus": 4,
+					"disk": 0,
+					"os-flavor-access:is_public": false,
+					"OS-FLV-EXT-DATA:ephemeral": 10
+				}
+			}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"flavor": {
+					"id": "a0dbfc8f-e4e2-4ab6-a1d0-4d1d6a4b5e36",
+					"name": "m1.numa",
+					"disk": 0,
+					"ram": 4096,
+					"vcpus": 4,
+					"swap": "",
+					"rxtx_factor": 1.0,
+					"os-flavor-access:is_public": false,
+					"OS-FLV-EXT-DATA:ephemeral": 10
+				}
+			}
+		`)
+	})
+
+	disk := 0
+	isPublic := false
+	ephemeral := 10
+	opts := flavors.CreateOpts{
+		Name:      "m1.numa",
+		RAM:       4096,
+		VCPUs:     4,
+		Disk:      &disk,
+		IsPublic:  &isPublic,
+		Ephemeral: &ephemeral,
+	}
+	actual, err := flavors.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &flavors.Flavor{
+		ID:         "a0dbfc8f-e4e2-4ab6-a1d0-4d1d6a4b5e36",
+		Name:       "m1.numa",
+		RAM:        4096,
+		VCPUs:      4,
+		RxTxFactor: 1.0,
+		Ephemeral:  10,
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestCreateFlavorRequiresDisk(t *testing.T) {
+	_, err := flavors.CreateOpts{Name: "m1.numa", RAM: 4096, VCPUs: 4}.ToFlavorCreateMap()
+	if err == nil {
+		t.Fatal("CreateOpts without a Disk should fail")
+	}
+}
+
+func TestDeleteFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors/12345", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := flavors.Delete(fake.ServiceClient(), "12345").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestFlavorAccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	const accessOutput = `
+		{
+			"flavor_access": [
+				{
+					"flavor_id": "12345",
+					"tenant_id": "2f954bcf047c4ee9b09a37d49ae6db54"
+				}
+			]
+		}
+	`
+
+	th.Mux.HandleFunc("/flavors/12345/os-flavor-access", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, accessOutput)
+	})
+
+	th.Mux.HandleFunc("/flavors/12345/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		var body map[string]map[string]string
+		th.AssertNoErr(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Add("Content-Type", "application/json")
+		switch {
+		case body["addTenantAccess"]["tenant"] == "2f954bcf047c4ee9b09a37d49ae6db54":
+			fmt.Fprint(w, accessOutput)
+		case body["removeTenantAccess"]["tenant"] == "2f954bcf047c4ee9b09a37d49ae6db54":
+			fmt.Fprint(w, `{"flavor_access": []}`)
+		default:
+			t.Fatalf("Unexpected action: %v", body)
+		}
+	})
+
+	expected := []flavors.FlavorAccess{
+		{FlavorID: "12345", TenantID: "2f954bcf047c4ee9b09a37d49ae6db54"},
+	}
+
+	allPages, err := flavors.ListAccesses(fake.ServiceClient(), "12345").AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := flavors.ExtractAccesses(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+
+	addOpts := flavors.AddAccessOpts{Tenant: "2f954bcf047c4ee9b09a37d49ae6db54"}
+	actual, err = flavors.AddAccess(fake.ServiceClient(), "12345", addOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+
+	removeOpts := flavors.RemoveAccessOpts{Tenant: "2f954bcf047c4ee9b09a37d49ae6db54"}
+	actual, err = flavors.RemoveAccess(fake.ServiceClient(), "12345", removeOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(actual))
+}
+
+func TestFlavorExtraSpecs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors/12345/os-extra_specs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			fmt.Fprintf(w, `{"extra_specs": {"hw:numa_nodes": "2", "hw:mem_page_size": "large"}}`)
+		case "POST":
+			th.TestJSONRequest(t, r, `{"extra_specs": {"hw:numa_nodes": "2", "hw:mem_page_size": "large"}}`)
+			fmt.Fprintf(w, `{"extra_specs": {"hw:numa_nodes": "2", "hw:mem_page_size": "large"}}`)
+		default:
+			t.Fatalf("Unexpected method %s", r.Method)
+		}
+	})
+
+	th.Mux.HandleFunc("/flavors/12345/os-extra_specs/hw:numa_nodes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		switch r.Method {
+		case "GET":
+			fmt.Fprintf(w, `{"hw:numa_nodes": "2"}`)
+		case "PUT":
+			th.TestJSONRequest(t, r, `{"hw:numa_nodes": "1"}`)
+			fmt.Fprintf(w, `{"hw:numa_nodes": "1"}`)
+		case "DELETE":
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Fatalf("Unexpected method %s", r.Method)
+		}
+	})
+
+	specs := map[string]string{"hw:numa_nodes": "2", "hw:mem_page_size": "large"}
+
+	actual, err := flavors.ListExtraSpecs(fake.ServiceClient(), "12345").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, specs, actual)
+
+	actual, err = flavors.CreateExtraSpecs(fake.ServiceClient(), "12345", flavors.ExtraSpecsOpts(specs)).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, specs, actual)
+
+	actual, err = flavors.GetExtraSpec(fake.ServiceClient(), "12345", "hw:numa_nodes").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, map[string]string{"hw:numa_nodes": "2"}, actual)
+
+	updateOpts := flavors.ExtraSpecsOpts{"hw:numa_nodes": "1"}
+	actual, err = flavors.UpdateExtraSpec(fake.ServiceClient(), "12345", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, map[string]string{"hw:numa_nodes": "1"}, actual)
+
+	err = flavors.DeleteExtraSpec(fake.ServiceClient(), "12345", "hw:numa_nodes").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	_, err = flavors.UpdateExtraSpec(fake.ServiceClient(), "12345", flavors.ExtraSpecsOpts(specs)).Extract()
+	if err == nil {
+		t.Fatal("UpdateExtraSpec with two keys should fail")
+	}
+}
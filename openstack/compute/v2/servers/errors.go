@@ -54,6 +54,11 @@ func (se ErrServer) Error() string {
 	return fmt.Sprintf("Error while executing HTTP request for server [%s]", se.ID)
 }
 
+// Unwrap returns the underlying ErrUnexpectedResponseCode.
+func (se ErrServer) Unwrap() error {
+	return se.ErrUnexpectedResponseCode
+}
+
 // Error404 overrides the generic 404 error message.
 func (se ErrServer) Error404(e gophercloud.ErrUnexpectedResponseCode) error {
 	se.ErrUnexpectedResponseCode = e
@@ -73,6 +73,11 @@ func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pa
 	})
 }
 
+// ListAll returns the servers matching opts from every page of a List request.
+func ListAll(client *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Server, error) {
+	return pagination.All(List(client, opts), ExtractServers)
+}
+
 // CreateOptsBuilder describes struct types that can be accepted by the Create call.
 // The CreateOpts struct in this package does.
 type CreateOptsBuilder interface {
@@ -219,11 +219,7 @@ func (page ServerPage) NextPageURL() (string, error) {
 
 // ExtractServers interprets the results of a single page from a List() call, producing a slice of Server entities.
 func ExtractServers(r pagination.Page) ([]Server, error) {
-	var s struct {
-		Servers []Server `json:"servers"`
-	}
-	err := (r.(ServerPage)).ExtractInto(&s)
-	return s.Servers, err
+	return pagination.ExtractItems[Server](r, "servers")
 }
 
 // MetadataResult contains the result of a call for (potentially) multiple key-value pairs.
@@ -941,3 +941,20 @@ func HandlePasswordGetSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, ServerPasswordBody)
 	})
 }
+
+// HandleServerGetStatusesSuccessfully sets up the test server to respond to server Get requests
+// with the given statuses in turn, repeating the last one.
+func HandleServerGetStatusesSuccessfully(t *testing.T, statuses ...string) {
+	th.Mux.HandleFunc("/servers/1234asdf", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"server": {"id": "1234asdf", "status": "%s"}}`, status)
+	})
+}
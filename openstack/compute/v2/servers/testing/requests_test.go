@@ -1,11 +1,14 @@
 package testing
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/pagination"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -56,6 +59,16 @@ func TestListAllServers(t *testing.T) {
 	th.CheckDeepEquals(t, ServerDerp, actual[1])
 }
 
+func TestListAllServersTyped(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerListSuccessfully(t)
+
+	actual, err := servers.ListAll(client.ServiceClient(), servers.ListOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []servers.Server{ServerHerp, ServerDerp, ServerMerp}, actual)
+}
+
 func TestCreateServer(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -445,3 +458,48 @@ func TestMarshalPersonality(t *testing.T) {
 		t.Fatal("file contents incorrect")
 	}
 }
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetStatusesSuccessfully(t, "BUILD", "BUILD", "ACTIVE")
+
+	err := servers.WaitForStatusContext(context.Background(), client.ServiceClient(), "1234asdf", "ACTIVE", gophercloud.WaitOpts{
+		Timeout:  5 * time.Second,
+		Interval: time.Millisecond,
+	})
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStatusFailsOnError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetStatusesSuccessfully(t, "BUILD", "ERROR")
+
+	err := servers.WaitForStatusContext(context.Background(), client.ServiceClient(), "1234asdf", "ACTIVE", gophercloud.WaitOpts{
+		Timeout:  5 * time.Second,
+		Interval: time.Millisecond,
+	})
+	th.AssertEquals(t, gophercloud.ErrUnexpectedStatus{Expected: "ACTIVE", Actual: "ERROR"}, err)
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetStatusesSuccessfully(t, "BUILD")
+
+	err := servers.WaitForStatusContext(context.Background(), client.ServiceClient(), "1234asdf", "ACTIVE", gophercloud.WaitOpts{
+		Timeout:  50 * time.Millisecond,
+		Interval: time.Millisecond,
+	})
+	th.AssertEquals(t, gophercloud.ErrTimeOut{}, err)
+}
+
+func TestWaitForStatusSeconds(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerGetStatusesSuccessfully(t, "ERROR")
+
+	err := servers.WaitForStatus(client.ServiceClient(), "1234asdf", "ACTIVE", 5)
+	th.AssertEquals(t, gophercloud.ErrUnexpectedStatus{Expected: "ACTIVE", Actual: "ERROR"}, err)
+}
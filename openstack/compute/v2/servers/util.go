@@ -1,20 +1,35 @@
 package servers
 
-import "github.com/gophercloud/gophercloud"
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
 
 // WaitForStatus will continually poll a server until it successfully transitions to a specified
-// status. It will do this for at most the number of seconds specified.
+// status. It will do this for at most the number of seconds specified, and fails fast with an
+// ErrUnexpectedStatus when a server enters the ERROR status.
 func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
-	return gophercloud.WaitFor(secs, func() (bool, error) {
-		current, err := Get(c, id).Extract()
-		if err != nil {
-			return false, err
-		}
+	return gophercloud.WaitForStatus(secs, func() (string, error) {
+		return getStatus(c, id)
+	}, status, errorStatuses...)
+}
+
+// WaitForStatusContext behaves like WaitForStatus, but polls as configured by opts until ctx is
+// done. The requests are bound to ctx.
+func WaitForStatusContext(ctx context.Context, c *gophercloud.ServiceClient, id, status string, opts gophercloud.WaitOpts) error {
+	return gophercloud.WaitForStatusContext(ctx, opts, func(ctx context.Context) (string, error) {
+		return getStatus(c.WithContext(ctx), id)
+	}, status, errorStatuses...)
+}
 
-		if current.Status == status {
-			return true, nil
-		}
+// errorStatuses are the server statuses WaitForStatus fails fast on.
+var errorStatuses = []string{"ERROR"}
 
-		return false, nil
-	})
+func getStatus(c *gophercloud.ServiceClient, id string) (string, error) {
+	s, err := Get(c, id).Extract()
+	if err != nil {
+		return "", err
+	}
+	return s.Status, nil
 }
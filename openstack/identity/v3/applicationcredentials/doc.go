@@ -0,0 +1,53 @@
+/*
+Package applicationcredentials provides information and interaction with the
+application credentials API resource in the OpenStack Identity service.
+
+Application credentials let applications authenticate as a user, without the
+user's password, on the project they were created on. See the
+ApplicationCredentialID and ApplicationCredentialSecret fields of
+gophercloud.AuthOptions to authenticate with one.
+
+For more information, see:
+https://developer.openstack.org/api-ref/identity/v3/#application-credentials
+
+Example to List Application Credentials
+
+	allPages, err := applicationcredentials.List(identityClient, userID, nil).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allCredentials, err := applicationcredentials.ExtractApplicationCredentials(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, credential := range allCredentials {
+		fmt.Printf("%+v\n", credential)
+	}
+
+Example to Create an Application Credential
+
+	expiresAt := time.Now().Add(24 * time.Hour)
+	createOpts := applicationcredentials.CreateOpts{
+		Name:      "ci",
+		Roles:     []applicationcredentials.Role{{Name: "member"}},
+		ExpiresAt: &expiresAt,
+	}
+
+	credential, err := applicationcredentials.Create(identityClient, userID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	// The secret is only returned on creation.
+	fmt.Println(credential.ID, credential.Secret)
+
+Example to Delete an Application Credential
+
+	err := applicationcredentials.Delete(identityClient, userID, credentialID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package applicationcredentials
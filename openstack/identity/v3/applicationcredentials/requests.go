@@ -0,0 +1,115 @@
+package applicationcredentials
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the List
+// request.
+type ListOptsBuilder interface {
+	ToApplicationCredentialListQuery() (string, error)
+}
+
+// ListOpts allows filtering the application credentials returned by List.
+type ListOpts struct {
+	// Name filters the response by an application credential name.
+	Name string `q:"name"`
+}
+
+// ToApplicationCredentialListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToApplicationCredentialListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the application credentials of a user.
+func List(client *gophercloud.ServiceClient, userID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client, userID)
+	if opts != nil {
+		query, err := opts.ToApplicationCredentialListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ApplicationCredentialPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves the details of an application credential of a user.
+func Get(client *gophercloud.ServiceClient, userID, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, userID, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToApplicationCredentialCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create an application credential.
+type CreateOpts struct {
+	// Name is the name of the application credential, unique for the user.
+	Name string `json:"name" required:"true"`
+
+	// Description is a description of the application credential.
+	Description string `json:"description,omitempty"`
+
+	// Unrestricted allows the application credential to create or delete
+	// other application credentials and trusts. It's a security risk, and
+	// defaults to false.
+	Unrestricted bool `json:"unrestricted,omitempty"`
+
+	// Secret is the secret of the application credential. It's generated
+	// when omitted.
+	Secret string `json:"secret,omitempty"`
+
+	// Roles restricts the roles of the user, on the project the token
+	// creating the application credential is scoped to, that the application
+	// credential gets. Defaults to all of them.
+	Roles []Role `json:"roles,omitempty"`
+
+	// ExpiresAt is when the application credential expires. It never
+	// expires when nil.
+	ExpiresAt *time.Time `json:"-"`
+}
+
+// ToApplicationCredentialCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToApplicationCredentialCreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "application_credential")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ExpiresAt != nil {
+		b["application_credential"].(map[string]interface{})["expires_at"] = opts.ExpiresAt.UTC().Format(gophercloud.RFC3339MilliNoZ)
+	}
+
+	return b, nil
+}
+
+// Create creates an application credential for a user, on the project the
+// client's token is scoped to. The secret of the credential is only returned
+// by Create.
+func Create(client *gophercloud.ServiceClient, userID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToApplicationCredentialCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client, userID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// Delete deletes an application credential of a user.
+func Delete(client *gophercloud.ServiceClient, userID, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, userID, id), nil)
+	return
+}
@@ -0,0 +1,128 @@
+package applicationcredentials
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Role is a role granted to an application credential.
+type Role struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	DomainID string `json:"domain_id,omitempty"`
+}
+
+// ApplicationCredential represents an application credential of a user.
+type ApplicationCredential struct {
+	// ID is the unique ID of the application credential.
+	ID string `json:"id"`
+
+	// Name is the name of the application credential.
+	Name string `json:"name"`
+
+	// Description is the description of the application credential.
+	Description string `json:"description"`
+
+	// Unrestricted tells whether the application credential can create or
+	// delete other application credentials and trusts.
+	Unrestricted bool `json:"unrestricted"`
+
+	// Secret is the secret of the application credential. It's only returned
+	// by Create.
+	Secret string `json:"secret"`
+
+	// ProjectID is the ID of the project the application credential is
+	// scoped to.
+	ProjectID string `json:"project_id"`
+
+	// Roles are the roles the application credential has on its project.
+	Roles []Role `json:"roles"`
+
+	// ExpiresAt is when the application credential expires. It's the zero
+	// time for credentials that never expire.
+	ExpiresAt time.Time `json:"-"`
+
+	// Links contains referencing links to the application credential.
+	Links map[string]interface{} `json:"links"`
+}
+
+func (r *ApplicationCredential) UnmarshalJSON(b []byte) error {
+	type tmp ApplicationCredential
+	var s struct {
+		tmp
+		ExpiresAt string `json:"expires_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ApplicationCredential(s.tmp)
+
+	if s.ExpiresAt != "" {
+		// Depending on its version, Keystone may or may not add the time zone.
+		r.ExpiresAt, err = time.Parse(time.RFC3339Nano, s.ExpiresAt)
+		if err != nil {
+			r.ExpiresAt, err = time.Parse(gophercloud.RFC3339MilliNoZ, s.ExpiresAt)
+		}
+	}
+
+	return err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any application credential result as an
+// ApplicationCredential.
+func (r commonResult) Extract() (*ApplicationCredential, error) {
+	var s struct {
+		ApplicationCredential *ApplicationCredential `json:"application_credential"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ApplicationCredential, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as an ApplicationCredential.
+type GetResult struct {
+	commonResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as an ApplicationCredential.
+type CreateResult struct {
+	commonResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ApplicationCredentialPage is a single page of ApplicationCredential
+// results.
+type ApplicationCredentialPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not an ApplicationCredentialPage contains any
+// results.
+func (r ApplicationCredentialPage) IsEmpty() (bool, error) {
+	credentials, err := ExtractApplicationCredentials(r)
+	return len(credentials) == 0, err
+}
+
+// ExtractApplicationCredentials returns a slice of ApplicationCredentials
+// contained in a single page of results.
+func ExtractApplicationCredentials(r pagination.Page) ([]ApplicationCredential, error) {
+	var s struct {
+		ApplicationCredentials []ApplicationCredential `json:"application_credentials"`
+	}
+	err := (r.(ApplicationCredentialPage)).ExtractInto(&s)
+	return s.ApplicationCredentials, err
+}
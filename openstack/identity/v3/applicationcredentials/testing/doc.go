@@ -0,0 +1,2 @@
+// applicationcredentials
+package testing
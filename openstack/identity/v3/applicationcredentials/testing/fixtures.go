@@ -0,0 +1,222 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// ListOutput provides a single page of ApplicationCredential results.
+const ListOutput = `
+{
+  "links": {
+    "self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials",
+    "previous": null,
+    "next": null
+  },
+  "application_credentials": [
+    {
+      "links": {
+        "self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7"
+      },
+      "description": null,
+      "roles": [
+        {
+          "domain_id": null,
+          "name": "compute_viewer",
+          "id": "31f87923ae4a4d119aa0b85dcdbeed13"
+        }
+      ],
+      "expires_at": null,
+      "unrestricted": false,
+      "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+      "id": "c4859fb437df4b87a51a8f5adcfb0bc7",
+      "name": "test1"
+    },
+    {
+      "links": {
+        "self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb"
+      },
+      "description": "ci credential",
+      "roles": [
+        {
+          "domain_id": null,
+          "name": "member",
+          "id": "9fe2ff9ee4384b1894a90878d3e92bab"
+        }
+      ],
+      "expires_at": "2019-03-12T12:12:12.123456",
+      "unrestricted": true,
+      "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+      "id": "6b8cc7647da64166a4a3cc0c88ebbabb",
+      "name": "test2"
+    }
+  ]
+}
+`
+
+// GetOutput provides a Get result.
+const GetOutput = `
+{
+  "application_credential": {
+    "links": {
+      "self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7"
+    },
+    "description": null,
+    "roles": [
+      {
+        "domain_id": null,
+        "name": "compute_viewer",
+        "id": "31f87923ae4a4d119aa0b85dcdbeed13"
+      }
+    ],
+    "expires_at": null,
+    "unrestricted": false,
+    "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+    "id": "c4859fb437df4b87a51a8f5adcfb0bc7",
+    "name": "test1"
+  }
+}
+`
+
+// CreateRequest provides the input to a Create request.
+const CreateRequest = `
+{
+  "application_credential": {
+    "name": "test2",
+    "description": "ci credential",
+    "secret": "mysecret",
+    "unrestricted": true,
+    "expires_at": "2019-03-12T12:12:12.123456",
+    "roles": [
+      {
+        "id": "9fe2ff9ee4384b1894a90878d3e92bab"
+      }
+    ]
+  }
+}
+`
+
+// CreateResponse provides the output of a Create request.
+const CreateResponse = `
+{
+  "application_credential": {
+    "links": {
+      "self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb"
+    },
+    "description": "ci credential",
+    "roles": [
+      {
+        "domain_id": null,
+        "name": "member",
+        "id": "9fe2ff9ee4384b1894a90878d3e92bab"
+      }
+    ],
+    "expires_at": "2019-03-12T12:12:12.123456",
+    "unrestricted": true,
+    "secret": "mysecret",
+    "project_id": "53c2b94f63fb4f43a21b92d119ce549f",
+    "id": "6b8cc7647da64166a4a3cc0c88ebbabb",
+    "name": "test2"
+  }
+}
+`
+
+// UserID is the ID of the user owning the application credentials.
+const UserID = "2844b2a08be147a08ef58317d6471f1f"
+
+// FirstApplicationCredential is the first application credential in the List
+// request.
+var FirstApplicationCredential = applicationcredentials.ApplicationCredential{
+	ID:        "c4859fb437df4b87a51a8f5adcfb0bc7",
+	Name:      "test1",
+	ProjectID: "53c2b94f63fb4f43a21b92d119ce549f",
+	Roles: []applicationcredentials.Role{
+		{ID: "31f87923ae4a4d119aa0b85dcdbeed13", Name: "compute_viewer"},
+	},
+	Links: map[string]interface{}{
+		"self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/c4859fb437df4b87a51a8f5adcfb0bc7",
+	},
+}
+
+// SecondApplicationCredential is the second application credential in the
+// List request.
+var SecondApplicationCredential = applicationcredentials.ApplicationCredential{
+	ID:           "6b8cc7647da64166a4a3cc0c88ebbabb",
+	Name:         "test2",
+	Description:  "ci credential",
+	Unrestricted: true,
+	ProjectID:    "53c2b94f63fb4f43a21b92d119ce549f",
+	Roles: []applicationcredentials.Role{
+		{ID: "9fe2ff9ee4384b1894a90878d3e92bab", Name: "member"},
+	},
+	ExpiresAt: time.Date(2019, 3, 12, 12, 12, 12, 123456000, time.UTC),
+	Links: map[string]interface{}{
+		"self": "http://identity:5000/v3/users/2844b2a08be147a08ef58317d6471f1f/application_credentials/6b8cc7647da64166a4a3cc0c88ebbabb",
+	},
+}
+
+// ExpectedApplicationCredentialsSlice is the slice of application credentials
+// expected to be returned from ListOutput.
+var ExpectedApplicationCredentialsSlice = []applicationcredentials.ApplicationCredential{FirstApplicationCredential, SecondApplicationCredential}
+
+// HandleListApplicationCredentialsSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials` on the test handler mux that
+// responds with a list of two application credentials.
+func HandleListApplicationCredentialsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+UserID+"/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, ListOutput)
+	})
+}
+
+// HandleGetApplicationCredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials/{id}` on the test handler mux
+// that responds with a single application credential.
+func HandleGetApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+UserID+"/application_credentials/"+FirstApplicationCredential.ID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, GetOutput)
+	})
+}
+
+// HandleCreateApplicationCredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials` on the test handler mux that
+// tests application credential creation.
+func HandleCreateApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+UserID+"/application_credentials", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, CreateResponse)
+	})
+}
+
+// HandleDeleteApplicationCredentialSuccessfully creates an HTTP handler at
+// `/users/{user_id}/application_credentials/{id}` on the test handler mux
+// that tests application credential deletion.
+func HandleDeleteApplicationCredentialSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/users/"+UserID+"/application_credentials/"+FirstApplicationCredential.ID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
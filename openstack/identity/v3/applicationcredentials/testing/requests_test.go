@@ -0,0 +1,75 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/gophercloud/pagination"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestListApplicationCredentials(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListApplicationCredentialsSuccessfully(t)
+
+	count := 0
+	err := applicationcredentials.List(client.ServiceClient(), UserID, nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := applicationcredentials.ExtractApplicationCredentials(page)
+		th.AssertNoErr(t, err)
+
+		th.CheckDeepEquals(t, ExpectedApplicationCredentialsSlice, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, count, 1)
+}
+
+func TestGetApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetApplicationCredentialSuccessfully(t)
+
+	actual, err := applicationcredentials.Get(client.ServiceClient(), UserID, FirstApplicationCredential.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstApplicationCredential, *actual)
+}
+
+func TestCreateApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateApplicationCredentialSuccessfully(t)
+
+	expiresAt := time.Date(2019, 3, 12, 12, 12, 12, 123456000, time.UTC)
+	createOpts := applicationcredentials.CreateOpts{
+		Name:         "test2",
+		Description:  "ci credential",
+		Secret:       "mysecret",
+		Unrestricted: true,
+		ExpiresAt:    &expiresAt,
+		Roles: []applicationcredentials.Role{
+			{ID: "9fe2ff9ee4384b1894a90878d3e92bab"},
+		},
+	}
+
+	expected := SecondApplicationCredential
+	expected.Secret = "mysecret"
+
+	actual, err := applicationcredentials.Create(client.ServiceClient(), UserID, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, *actual)
+}
+
+func TestDeleteApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteApplicationCredentialSuccessfully(t)
+
+	res := applicationcredentials.Delete(client.ServiceClient(), UserID, FirstApplicationCredential.ID)
+	th.AssertNoErr(t, res.Err)
+}
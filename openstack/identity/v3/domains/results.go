@@ -0,0 +1,82 @@
+package domains
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// A Domain is a collection of projects, users, and roles.
+type Domain struct {
+	// Description is the description of the Domain.
+	Description string `json:"description"`
+
+	// Enabled is whether or not the domain is enabled.
+	Enabled bool `json:"enabled"`
+
+	// ID is the unique ID of the domain.
+	ID string `json:"id"`
+
+	// Links contains referencing links to the domain.
+	Links map[string]interface{} `json:"links"`
+
+	// Name is the name of the domain.
+	Name string `json:"name"`
+}
+
+type domainResult struct {
+	gophercloud.Result
+}
+
+// GetResult is the result of a Get request. Call its Extract method to
+// interpret it as a Domain.
+type GetResult struct {
+	domainResult
+}
+
+// CreateResult is the result of a Create request. Call its Extract method to
+// interpret it as a Domain.
+type CreateResult struct {
+	domainResult
+}
+
+// DeleteResult is the result of a Delete request. Call its ExtractErr method to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateResult is the result of an Update request. Call its Extract method to
+// interpret it as a Domain.
+type UpdateResult struct {
+	domainResult
+}
+
+// DomainPage is a single page of Domain results.
+type DomainPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Domains contains any results.
+func (r DomainPage) IsEmpty() (bool, error) {
+	domains, err := ExtractDomains(r)
+	return len(domains) == 0, err
+}
+
+// ExtractDomains returns a slice of Domains contained in a single page of
+// results.
+func ExtractDomains(r pagination.Page) ([]Domain, error) {
+	var s struct {
+		Domains []Domain `json:"domains"`
+	}
+	err := (r.(DomainPage)).ExtractInto(&s)
+	return s.Domains, err
+}
+
+// Extract interprets any domainResult as a Domain.
+func (r domainResult) Extract() (*Domain, error) {
+	var s struct {
+		Domain *Domain `json:"domain"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Domain, err
+}
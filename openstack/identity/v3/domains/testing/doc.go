@@ -0,0 +1,2 @@
+// domains unit tests
+package testing
@@ -0,0 +1,61 @@
+/*
+Package groups manages and retrieves Groups in the OpenStack Identity Service.
+
+See the users package to manage the members of a group.
+
+Example to List Groups
+
+	listOpts := groups.ListOpts{
+		DomainID: "default",
+	}
+
+	allPages, err := groups.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allGroups, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, group := range allGroups {
+		fmt.Printf("%+v\n", group)
+	}
+
+Example to Create a Group
+
+	createOpts := groups.CreateOpts{
+		Name:        "groupname",
+		DomainID:    "default",
+		Description: "Support group",
+	}
+
+	group, err := groups.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Group
+
+	groupID := "0fe36e73809d46aeae6705c39077b1b3"
+
+	description := "Updated description"
+	updateOpts := groups.UpdateOpts{
+		Description: &description,
+	}
+
+	group, err := groups.Update(identityClient, groupID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Group
+
+	groupID := "0fe36e73809d46aeae6705c39077b1b3"
+	err := groups.Delete(identityClient, groupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groups
@@ -0,0 +1,2 @@
+// projects unit tests
+package testing
@@ -0,0 +1,79 @@
+package regions
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Region groups the endpoints of a geographical area or deployment.
+type Region struct {
+	// Description describes the region purpose.
+	Description string `json:"description"`
+
+	// ID is the unique ID of the region.
+	ID string `json:"id"`
+
+	// Links contains referencing links to the region.
+	Links map[string]interface{} `json:"links"`
+
+	// ParentRegionID is the ID of the parent region, if any.
+	ParentRegionID string `json:"parent_region_id"`
+}
+
+type regionResult struct {
+	gophercloud.Result
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Region.
+type GetResult struct {
+	regionResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method
+// to interpret it as a Region.
+type CreateResult struct {
+	regionResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Region.
+type UpdateResult struct {
+	regionResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// RegionPage is a single page of Region results.
+type RegionPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Regions contains any results.
+func (r RegionPage) IsEmpty() (bool, error) {
+	regions, err := ExtractRegions(r)
+	return len(regions) == 0, err
+}
+
+// ExtractRegions returns a slice of Regions contained in a single page of
+// results.
+func ExtractRegions(r pagination.Page) ([]Region, error) {
+	var s struct {
+		Regions []Region `json:"regions"`
+	}
+	err := (r.(RegionPage)).ExtractInto(&s)
+	return s.Regions, err
+}
+
+// Extract interprets any region results as a Region.
+func (r regionResult) Extract() (*Region, error) {
+	var s struct {
+		Region *Region `json:"region"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Region, err
+}
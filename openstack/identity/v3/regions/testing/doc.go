@@ -0,0 +1,2 @@
+// regions unit tests
+package testing
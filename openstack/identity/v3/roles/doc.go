@@ -1,3 +1,90 @@
-// Package roles provides information and interaction with the roles API
-// resource for the OpenStack Identity service.
+/*
+Package roles provides information and interaction with the roles API
+resource for the OpenStack Identity service.
+
+Example to List Roles
+
+	listOpts := roles.ListOpts{
+		DomainID: "default",
+	}
+
+	allPages, err := roles.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRoles, err := roles.ExtractRoles(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, role := range allRoles {
+		fmt.Printf("%+v\n", role)
+	}
+
+Example to Create a Role
+
+	createOpts := roles.CreateOpts{
+		Name:     "read-only-admin",
+		DomainID: "default",
+	}
+
+	role, err := roles.Create(identityClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Role
+
+	roleID := "d94f32f4a4a54f4ba4a6f1e3f4be6b6c"
+	err := roles.Delete(identityClient, roleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Role Assignments
+
+	listOpts := roles.ListAssignmentsOpts{
+		UserID:    "97061de2ed0647b28a393c36ab584f39",
+		Effective: gophercloud.Enabled,
+	}
+
+	allPages, err := roles.ListAssignments(identityClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAssignments, err := roles.ExtractRoleAssignments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Assign a Role to a User on a Project
+
+	roleID := "d94f32f4a4a54f4ba4a6f1e3f4be6b6c"
+
+	assignOpts := roles.AssignOpts{
+		UserID:    "97061de2ed0647b28a393c36ab584f39",
+		ProjectID: "9df1a02f5eb2416a9781e8b0c022d3ae",
+	}
+
+	err := roles.Assign(identityClient, roleID, assignOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Unassign a Role from a Group on a Domain
+
+	roleID := "d94f32f4a4a54f4ba4a6f1e3f4be6b6c"
+
+	unassignOpts := roles.AssignOpts{
+		GroupID:  "bede500ee1124ae9b0006ff859758b3a",
+		DomainID: "default",
+	}
+
+	err := roles.Unassign(identityClient, roleID, unassignOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
 package roles
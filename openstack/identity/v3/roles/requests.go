@@ -1,6 +1,8 @@
 package roles
 
 import (
+	"fmt"
+
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/pagination"
 )
@@ -45,3 +47,183 @@ func ListAssignments(client *gophercloud.ServiceClient, opts ListAssignmentsOpts
 		return RoleAssignmentPage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
+
+// ListOptsBuilder allows extensions to add additional parameters to
+// the List request
+type ListOptsBuilder interface {
+	ToRoleListQuery() (string, error)
+}
+
+// ListOpts provides options to filter the List results.
+type ListOpts struct {
+	// DomainID filters the response by a domain ID.
+	DomainID string `q:"domain_id"`
+
+	// Name filters the response by role name.
+	Name string `q:"name"`
+}
+
+// ToRoleListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToRoleListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List enumerates the roles to which the current token has access.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToRoleListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return RolePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves details on a single role, by ID.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to
+// the Create request.
+type CreateOptsBuilder interface {
+	ToRoleCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts provides options used to create a role.
+type CreateOpts struct {
+	// Name is the name of the new role.
+	Name string `json:"name" required:"true"`
+
+	// DomainID is the ID of the domain the role belongs to. Leave it empty to
+	// create a global role.
+	DomainID string `json:"domain_id,omitempty"`
+}
+
+// ToRoleCreateMap formats a CreateOpts into a create request.
+func (opts CreateOpts) ToRoleCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "role")
+}
+
+// Create creates a new Role.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToRoleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to
+// the Update request.
+type UpdateOptsBuilder interface {
+	ToRoleUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts provides options for updating a role.
+type UpdateOpts struct {
+	// Name is the name of the role.
+	Name string `json:"name,omitempty"`
+}
+
+// ToRoleUpdateMap formats a UpdateOpts into an update request.
+func (opts UpdateOpts) ToRoleUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "role")
+}
+
+// Update updates an existing Role.
+func Update(client *gophercloud.ServiceClient, roleID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToRoleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(updateURL(client, roleID), &b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a role, along with its assignments.
+func Delete(client *gophercloud.ServiceClient, roleID string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, roleID), nil)
+	return
+}
+
+// AssignOpts provides options to assign a role, or remove its assignment.
+// Exactly one of UserID and GroupID, and exactly one of ProjectID and DomainID,
+// must be given.
+type AssignOpts struct {
+	// UserID is the ID of a user to assign the role to.
+	UserID string
+
+	// GroupID is the ID of a group to assign the role to.
+	GroupID string
+
+	// ProjectID is the ID of the project the role applies to.
+	ProjectID string
+
+	// DomainID is the ID of the domain the role applies to.
+	DomainID string
+}
+
+// toAssignURL returns the URL of the assignment of roleID described by opts.
+func (opts AssignOpts) toAssignURL(client *gophercloud.ServiceClient, roleID string) (string, error) {
+	var actorType, actorID string
+	switch {
+	case opts.UserID != "" && opts.GroupID == "":
+		actorType, actorID = "users", opts.UserID
+	case opts.GroupID != "" && opts.UserID == "":
+		actorType, actorID = "groups", opts.GroupID
+	default:
+		return "", fmt.Errorf("Specify exactly one of UserID or GroupID")
+	}
+
+	var targetType, targetID string
+	switch {
+	case opts.ProjectID != "" && opts.DomainID == "":
+		targetType, targetID = "projects", opts.ProjectID
+	case opts.DomainID != "" && opts.ProjectID == "":
+		targetType, targetID = "domains", opts.DomainID
+	default:
+		return "", fmt.Errorf("Specify exactly one of ProjectID or DomainID")
+	}
+
+	return assignURL(client, targetType, targetID, actorType, actorID, roleID), nil
+}
+
+// Assign grants a role to a user or group on a project or domain.
+func Assign(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (r AssignmentResult) {
+	url, err := opts.toAssignURL(client, roleID)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(url, nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Unassign revokes a role from a user or group on a project or domain.
+func Unassign(client *gophercloud.ServiceClient, roleID string, opts AssignOpts) (r AssignmentResult) {
+	url, err := opts.toAssignURL(client, roleID)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Delete(url, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
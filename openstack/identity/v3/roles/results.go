@@ -1,6 +1,9 @@
 package roles
 
-import "github.com/gophercloud/gophercloud/pagination"
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
 
 // RoleAssignment is the result of a role assignments query.
 type RoleAssignment struct {
@@ -10,8 +13,84 @@ type RoleAssignment struct {
 	Group Group `json:"group,omitempty"`
 }
 
+// Role grants permissions to a user or group on a project or domain. Role
+// assignments only hold the ID of their role.
 type Role struct {
+	// DomainID is the domain ID the role belongs to. It's empty for global
+	// roles.
+	DomainID string `json:"domain_id,omitempty"`
+
+	// ID is the unique ID of the role.
 	ID string `json:"id,omitempty"`
+
+	// Links contains referencing links to the role.
+	Links map[string]interface{} `json:"links,omitempty"`
+
+	// Name is the role name.
+	Name string `json:"name,omitempty"`
+}
+
+type roleResult struct {
+	gophercloud.Result
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a Role.
+type GetResult struct {
+	roleResult
+}
+
+// CreateResult is the response from a Create operation. Call its Extract method
+// to interpret it as a Role.
+type CreateResult struct {
+	roleResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a Role.
+type UpdateResult struct {
+	roleResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr to
+// determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AssignmentResult is the response from an Assign or Unassign operation. Call
+// its ExtractErr to determine if the request succeeded or failed.
+type AssignmentResult struct {
+	gophercloud.ErrResult
+}
+
+// RolePage is a single page of Role results.
+type RolePage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Roles contains any results.
+func (r RolePage) IsEmpty() (bool, error) {
+	roles, err := ExtractRoles(r)
+	return len(roles) == 0, err
+}
+
+// ExtractRoles returns a slice of Roles contained in a single page of results.
+func ExtractRoles(r pagination.Page) ([]Role, error) {
+	var s struct {
+		Roles []Role `json:"roles"`
+	}
+	err := (r.(RolePage)).ExtractInto(&s)
+	return s.Roles, err
+}
+
+// Extract interprets any role results as a Role.
+func (r roleResult) Extract() (*Role, error) {
+	var s struct {
+		Role *Role `json:"role"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Role, err
 }
 
 type Scope struct {
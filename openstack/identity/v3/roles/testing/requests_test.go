@@ -103,3 +103,104 @@ func TestListSinglePage(t *testing.T) {
 		t.Errorf("Expected 1 page, got %d", count)
 	}
 }
+
+func TestListRoles(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleListRolesSuccessfully(t)
+
+	count := 0
+	err := roles.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := roles.ExtractRoles(page)
+		testhelper.AssertNoErr(t, err)
+
+		testhelper.CheckDeepEquals(t, ExpectedRolesSlice, actual)
+
+		return true, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, count, 1)
+}
+
+func TestGetRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleGetRoleSuccessfully(t)
+
+	actual, err := roles.Get(client.ServiceClient(), "9fe1d3").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, SecondRole, *actual)
+}
+
+func TestCreateRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleCreateRoleSuccessfully(t)
+
+	createOpts := roles.CreateOpts{
+		Name:     "support",
+		DomainID: "1789d1",
+	}
+
+	actual, err := roles.Create(client.ServiceClient(), createOpts).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, SecondRole, *actual)
+}
+
+func TestUpdateRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleUpdateRoleSuccessfully(t)
+
+	updateOpts := roles.UpdateOpts{
+		Name: "L2 support",
+	}
+
+	actual, err := roles.Update(client.ServiceClient(), "9fe1d3", updateOpts).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, SecondRoleUpdated, *actual)
+}
+
+func TestDeleteRole(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleDeleteRoleSuccessfully(t)
+
+	res := roles.Delete(client.ServiceClient(), "9fe1d3")
+	testhelper.AssertNoErr(t, res.Err)
+}
+
+func TestAssignAndUnassign(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleAssignSuccessfully(t)
+
+	for _, opts := range []roles.AssignOpts{
+		{UserID: "a1b2c3", ProjectID: "123456"},
+		{GroupID: "d4e5f6", ProjectID: "123456"},
+		{UserID: "a1b2c3", DomainID: "default"},
+		{GroupID: "d4e5f6", DomainID: "default"},
+	} {
+		err := roles.Assign(client.ServiceClient(), "9fe1d3", opts).ExtractErr()
+		testhelper.AssertNoErr(t, err)
+
+		err = roles.Unassign(client.ServiceClient(), "9fe1d3", opts).ExtractErr()
+		testhelper.AssertNoErr(t, err)
+	}
+}
+
+func TestAssignInvalidOpts(t *testing.T) {
+	for _, opts := range []roles.AssignOpts{
+		{ProjectID: "123456"},
+		{UserID: "a1b2c3", GroupID: "d4e5f6", ProjectID: "123456"},
+		{UserID: "a1b2c3"},
+		{UserID: "a1b2c3", ProjectID: "123456", DomainID: "default"},
+	} {
+		err := roles.Assign(client.ServiceClient(), "9fe1d3", opts).ExtractErr()
+		if err == nil {
+			t.Errorf("Expected an error for %+v", opts)
+		}
+	}
+}
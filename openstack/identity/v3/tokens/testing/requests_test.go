@@ -279,6 +279,57 @@ func TestCreateExtractsTokenFromResponse(t *testing.T) {
 	}
 }
 
+func TestCreateApplicationCredentialIDAndSecret(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{ApplicationCredentialID: "12345abcdef", ApplicationCredentialSecret: "mysecret"}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"id": "12345abcdef",
+						"secret": "mysecret"
+					},
+					"methods": ["application_credential"]
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateApplicationCredentialNameAndUsername(t *testing.T) {
+	authTokenPost(t, tokens.AuthOptions{
+		ApplicationCredentialName:   "myappcred",
+		ApplicationCredentialSecret: "mysecret",
+		Username:                    "fenris",
+		DomainName:                  "default",
+	}, nil, `
+		{
+			"auth": {
+				"identity": {
+					"application_credential": {
+						"name": "myappcred",
+						"secret": "mysecret",
+						"user": {
+							"name": "fenris",
+							"domain": {
+								"name": "default"
+							}
+						}
+					},
+					"methods": ["application_credential"]
+				}
+			}
+		}
+	`)
+}
+
+func TestCreateFailureApplicationCredentialMissingSecret(t *testing.T) {
+	authTokenPostErr(t, tokens.AuthOptions{ApplicationCredentialID: "12345abcdef"}, nil, false, gophercloud.ErrAppCredMissingSecret{})
+}
+
+func TestCreateFailureApplicationCredentialNameWithoutUser(t *testing.T) {
+	authTokenPostErr(t, tokens.AuthOptions{ApplicationCredentialName: "myappcred", ApplicationCredentialSecret: "mysecret"}, nil, false, gophercloud.ErrUsernameOrUserID{})
+}
+
 func TestCreateFailureEmptyAuth(t *testing.T) {
 	authTokenPostErr(t, tokens.AuthOptions{}, nil, false, gophercloud.ErrMissingPassword{})
 }
@@ -0,0 +1,2 @@
+// users unit tests
+package testing
@@ -405,3 +405,24 @@ func TestRemoveInterface(t *testing.T) {
 	th.AssertEquals(t, "3f990102-4485-4df1-97a0-2c35bdb85b31", res.PortID)
 	th.AssertEquals(t, "9a83fa11-8da5-436e-9afe-3d3ac5ce7770", res.ID)
 }
+
+func TestListBoolFilters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"distributed": "false"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"routers": []}`)
+	})
+
+	distributed := false
+	allPages, err := routers.List(fake.ServiceClient(), routers.ListOpts{Distributed: &distributed}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := routers.ExtractRouters(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(actual))
+}
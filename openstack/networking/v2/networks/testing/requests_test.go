@@ -275,3 +275,25 @@ func TestDelete(t *testing.T) {
 	res := networks.Delete(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestListBoolFilters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"admin_state_up": "true", "shared": "false"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"networks": []}`)
+	})
+
+	adminStateUp, shared := true, false
+	opts := networks.ListOpts{AdminStateUp: &adminStateUp, Shared: &shared}
+	allPages, err := networks.List(fake.ServiceClient(), opts).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := networks.ExtractNetworks(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(actual))
+}
@@ -59,6 +59,11 @@ func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
 	})
 }
 
+// ListAll returns the ports matching opts from every page of a List request.
+func ListAll(c *gophercloud.ServiceClient, opts ListOptsBuilder) ([]Port, error) {
+	return pagination.All(List(c, opts), ExtractPorts)
+}
+
 // Get retrieves a specific port based on its unique ID.
 func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
 	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
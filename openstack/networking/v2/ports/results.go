@@ -111,9 +111,5 @@ func (r PortPage) IsEmpty() (bool, error) {
 // and extracts the elements into a slice of Port structs. In other words,
 // a generic collection is mapped into a relevant slice.
 func ExtractPorts(r pagination.Page) ([]Port, error) {
-	var s struct {
-		Ports []Port `json:"ports"`
-	}
-	err := (r.(PortPage)).ExtractInto(&s)
-	return s.Ports, err
+	return pagination.ExtractItems[Port](r, "ports")
 }
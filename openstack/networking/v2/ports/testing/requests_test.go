@@ -90,6 +90,71 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		switch r.URL.Query().Get("marker") {
+		case "":
+			fmt.Fprintf(w, `
+{
+    "ports": [
+        {
+            "id": "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+            "network_id": "70c1db1f-b701-45bd-96e0-a313ee3430b3",
+            "security_groups": []
+        }
+    ],
+    "ports_links": [
+        {
+            "href": "%s/v2.0/ports?marker=d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+            "rel": "next"
+        }
+    ]
+}
+			`, th.Server.URL)
+		case "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b":
+			fmt.Fprintf(w, `
+{
+    "ports": [
+        {
+            "id": "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2",
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "security_groups": ["f0ac4394-7e4a-4409-9701-ba8be283dbc3"]
+        }
+    ]
+}
+			`)
+		default:
+			t.Errorf("Unexpected marker %s", r.URL.Query().Get("marker"))
+		}
+	})
+
+	actual, err := ports.ListAll(fake.ServiceClient(), ports.ListOpts{})
+	th.AssertNoErr(t, err)
+
+	expected := []ports.Port{
+		{
+			ID:             "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+			NetworkID:      "70c1db1f-b701-45bd-96e0-a313ee3430b3",
+			SecurityGroups: []string{},
+		},
+		{
+			ID:             "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2",
+			NetworkID:      "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			SecurityGroups: []string{"f0ac4394-7e4a-4409-9701-ba8be283dbc3"},
+		},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
 func TestGet(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -355,3 +420,24 @@ func TestDelete(t *testing.T) {
 	res := ports.Delete(fake.ServiceClient(), "65c0ee9f-d634-4522-8954-51021b570b0d")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestListBoolFilters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"admin_state_up": "false"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"ports": []}`)
+	})
+
+	adminStateUp := false
+	allPages, err := ports.List(fake.ServiceClient(), ports.ListOpts{AdminStateUp: &adminStateUp}).AllPages()
+	th.AssertNoErr(t, err)
+	actual, err := ports.ExtractPorts(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(actual))
+}
@@ -0,0 +1,45 @@
+/*
+Package bulk deletes objects and containers, and extracts archives into
+objects, in bulk, through the bulk middleware of Object Storage.
+
+The limits of the middleware are discovered with the info package, from the
+/info document of the cluster.
+
+Example to Delete Objects in Bulk
+
+	paths := []string{
+		"my_container/object1",
+		"my_container/object2",
+		"my_container",
+	}
+
+	report, err := bulk.Delete(objectStorageClient, paths)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, e := range report.Errors {
+		fmt.Printf("Unable to delete %s: %s\n", e.Path, e.Status)
+	}
+
+Example to Extract an Archive into a Container
+
+	f, err := os.Open("site.tar.gz")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	extractOpts := bulk.ExtractOpts{
+		Content: f,
+		Format:  bulk.TarGz,
+	}
+
+	report, err := bulk.ExtractArchive(objectStorageClient, "my_container/site", extractOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Created %d objects\n", report.NumberFilesCreated)
+*/
+package bulk
@@ -0,0 +1,29 @@
+package bulk
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrNotSupported is the error when the cluster advertises that it doesn't
+// support a bulk operation.
+type ErrNotSupported struct {
+	gophercloud.BaseError
+	Operation string
+}
+
+func (e ErrNotSupported) Error() string {
+	return fmt.Sprintf("The cluster doesn't support %s", e.Operation)
+}
+
+// ErrBulkFailed is the error when a bulk operation failed altogether.
+type ErrBulkFailed struct {
+	gophercloud.BaseError
+	Status string
+	Body   string
+}
+
+func (e ErrBulkFailed) Error() string {
+	return fmt.Sprintf("Bulk operation failed with status %s: %s", e.Status, e.Body)
+}
@@ -0,0 +1,143 @@
+package bulk
+
+import (
+	"io"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/info"
+)
+
+// DefaultMaxDeletesPerRequest is the number of objects deleted per request
+// when the cluster doesn't advertise its limit.
+const DefaultMaxDeletesPerRequest = 10000
+
+// Delete deletes objects, and empty containers, in as few requests as the
+// cluster allows. The paths are in the "container/object" form, or name a
+// container alone. The number of paths sent per request is the
+// max_deletes_per_request limit the cluster advertises in /info, or
+// DefaultMaxDeletesPerRequest when it doesn't.
+//
+// The objects that couldn't be deleted are listed in the Errors of the
+// report, instead of failing the whole operation. An error is returned when a
+// request fails altogether, along with the report of the batches deleted
+// before it, whose objects are gone.
+func Delete(c *gophercloud.ServiceClient, paths []string) (*DeleteReport, error) {
+	report := &DeleteReport{}
+	if len(paths) == 0 {
+		return report, nil
+	}
+
+	batchSize := DefaultMaxDeletesPerRequest
+	capabilities, err := info.Get(c).Extract()
+	if err == nil {
+		if capabilities.BulkDelete == nil {
+			return nil, ErrNotSupported{Operation: "bulk delete"}
+		}
+		if capabilities.BulkDelete.MaxDeletesPerRequest > 0 {
+			batchSize = capabilities.BulkDelete.MaxDeletesPerRequest
+		}
+	}
+
+	for start := 0; start < len(paths); start += batchSize {
+		end := start + batchSize
+		if end > len(paths) {
+			end = len(paths)
+		}
+
+		lines := make([]string, end-start)
+		for i, p := range paths[start:end] {
+			lines[i] = "/" + escapePath(p)
+		}
+
+		var res bulkResponse
+		_, err := c.Request("POST", deleteURL(c), &gophercloud.RequestOpts{
+			RawBody:      strings.NewReader(strings.Join(lines, "\n")),
+			MoreHeaders:  map[string]string{"Content-Type": "text/plain"},
+			JSONResponse: &res,
+			OkCodes:      []int{200},
+		})
+		if err != nil {
+			return report, err
+		}
+		if err := res.err(); err != nil {
+			return report, err
+		}
+
+		report.NumberDeleted += res.NumberDeleted
+		report.NumberNotFound += res.NumberNotFound
+		report.Errors = append(report.Errors, res.itemErrors()...)
+	}
+
+	return report, nil
+}
+
+// ArchiveFormat is the format of an archive to extract.
+type ArchiveFormat string
+
+const (
+	// Tar is the format of uncompressed tar archives.
+	Tar ArchiveFormat = "tar"
+
+	// TarGz is the format of gzip compressed tar archives.
+	TarGz ArchiveFormat = "tar.gz"
+
+	// TarBz2 is the format of bzip2 compressed tar archives.
+	TarBz2 ArchiveFormat = "tar.bz2"
+)
+
+// ExtractOpts is a structure that holds parameters for extracting an archive.
+type ExtractOpts struct {
+	// (REQUIRED) Content is the archive, streamed as it's read.
+	Content io.Reader
+
+	// (REQUIRED) Format is the format of the archive.
+	Format ArchiveFormat
+}
+
+// ExtractArchive uploads an archive the cluster extracts into objects, under
+// uploadPath. When uploadPath is empty, each top-level directory of the
+// archive is extracted into a container, at most
+// max_containers_per_extraction of them. When it names a container, or a
+// container and an object prefix, the files of the archive are extracted in
+// it.
+//
+// The files that couldn't be extracted are listed in the Errors of the report,
+// up to the max_failed_extractions limit of the cluster, after which the
+// extraction stops. It returns an ErrNotSupported error when the cluster
+// advertises in /info that it doesn't support archive extraction.
+func ExtractArchive(c *gophercloud.ServiceClient, uploadPath string, opts ExtractOpts) (*ExtractReport, error) {
+	if opts.Content == nil {
+		return nil, gophercloud.ErrMissingInput{Argument: "Content"}
+	}
+	switch opts.Format {
+	case Tar, TarGz, TarBz2:
+	default:
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "Format"
+		err.Value = opts.Format
+		return nil, err
+	}
+
+	if capabilities, err := info.Get(c).Extract(); err == nil && capabilities.BulkUpload == nil {
+		return nil, ErrNotSupported{Operation: "archive extraction"}
+	}
+
+	var res bulkResponse
+	_, err := c.Request("PUT", extractURL(c, uploadPath, opts.Format), &gophercloud.RequestOpts{
+		RawBody:      opts.Content,
+		JSONResponse: &res,
+		OkCodes:      []int{200, 201},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := res.err(); err != nil {
+		return nil, err
+	}
+
+	return &ExtractReport{
+		NumberFilesCreated: res.NumberFilesCreated,
+		Errors:             res.itemErrors(),
+	}, nil
+}
@@ -0,0 +1,78 @@
+package bulk
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ItemError reports an object or a container a bulk operation failed on.
+type ItemError struct {
+	// Path is the path of the object, in the "container/object" form, or of the
+	// container.
+	Path string
+
+	// Status is the HTTP status of the operation on the item, like
+	// "409 Conflict".
+	Status string
+}
+
+func (e ItemError) Error() string {
+	return e.Path + ": " + e.Status
+}
+
+// DeleteReport reports the outcome of a bulk delete.
+type DeleteReport struct {
+	// NumberDeleted is the number of objects and containers deleted.
+	NumberDeleted int
+
+	// NumberNotFound is the number of objects and containers that didn't exist.
+	NumberNotFound int
+
+	// Errors are the objects and containers that couldn't be deleted.
+	Errors []ItemError
+}
+
+// ExtractReport reports the outcome of an archive extraction.
+type ExtractReport struct {
+	// NumberFilesCreated is the number of objects created.
+	NumberFilesCreated int
+
+	// Errors are the files of the archive that couldn't be extracted.
+	Errors []ItemError
+}
+
+// bulkResponse is the report Swift returns for bulk operations. Its status
+// is that of the operation as a whole, since the response is streamed and
+// always starts with a 200 status.
+type bulkResponse struct {
+	NumberDeleted      int        `json:"Number Deleted"`
+	NumberNotFound     int        `json:"Number Not Found"`
+	NumberFilesCreated int        `json:"Number Files Created"`
+	ResponseStatus     string     `json:"Response Status"`
+	ResponseBody       string     `json:"Response Body"`
+	Errors             [][]string `json:"Errors"`
+}
+
+// err returns the error of an operation that failed altogether, rather than
+// on some of its items.
+func (r bulkResponse) err() error {
+	if len(r.Errors) > 0 || strings.HasPrefix(r.ResponseStatus, "2") {
+		return nil
+	}
+	return ErrBulkFailed{Status: r.ResponseStatus, Body: r.ResponseBody}
+}
+
+func (r bulkResponse) itemErrors() []ItemError {
+	errs := make([]ItemError, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		if len(e) < 2 {
+			continue
+		}
+		path := e[0]
+		if p, err := url.PathUnescape(path); err == nil {
+			path = p
+		}
+		errs = append(errs, ItemError{Path: strings.TrimPrefix(path, "/"), Status: e[1]})
+	}
+	return errs
+}
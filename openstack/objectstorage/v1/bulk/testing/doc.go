@@ -0,0 +1,2 @@
+// objectstorage_bulk_v1
+package testing
@@ -0,0 +1,157 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// InfoResponse is a /info document advertising the bulk middleware.
+const InfoResponse = `
+{
+  "swift": {
+    "version": "2.23.1",
+    "max_file_size": 5368709122
+  },
+  "bulk_delete": {
+    "max_failed_deletes": 1000,
+    "max_deletes_per_request": 2
+  },
+  "bulk_upload": {
+    "max_failed_extractions": 1000,
+    "max_containers_per_extraction": 10000
+  }
+}
+`
+
+// InfoWithoutBulkResponse is a /info document of a cluster without the bulk
+// middleware.
+const InfoWithoutBulkResponse = `
+{
+  "swift": {
+    "version": "2.23.1"
+  }
+}
+`
+
+// DeleteResponses are the reports of the deletion of the batches of the
+// paths deleted in TestDelete.
+var DeleteResponses = map[string]string{
+	"/testContainer/a%20b\n/testContainer/c%C3%BC": `
+{
+  "Number Not Found": 1,
+  "Response Status": "200 OK",
+  "Errors": [],
+  "Number Deleted": 1,
+  "Response Body": ""
+}`,
+	"/testContainer": `
+{
+  "Number Not Found": 0,
+  "Response Status": "400 Bad Request",
+  "Errors": [["/testContainer", "409 Conflict"]],
+  "Number Deleted": 0,
+  "Response Body": ""
+}`,
+}
+
+// ExtractResponse is the report of an archive extraction.
+const ExtractResponse = `
+{
+  "Number Files Created": 2,
+  "Response Status": "400 Bad Request",
+  "Errors": [["/testContainer/site/bad%20file", "412 Precondition Failed"]],
+  "Response Body": ""
+}
+`
+
+// ExtractFailedResponse is the report of an archive extraction that failed
+// altogether.
+const ExtractFailedResponse = `
+{
+  "Number Files Created": 0,
+  "Response Status": "400 Bad Request",
+  "Errors": [],
+  "Response Body": "Invalid Tar File: not a gzip file"
+}
+`
+
+// HandleInfoSuccessfully creates an HTTP handler at `/info` on the test
+// handler mux that responds with the given /info document.
+func HandleInfoSuccessfully(t *testing.T, info string) {
+	th.Mux.HandleFunc("/info", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, info)
+	})
+}
+
+// HandleDeleteSuccessfully creates an HTTP handler at `/` on the test handler
+// mux that responds to bulk delete requests with DeleteResponses, and
+// returns the number of requests it handled.
+func HandleDeleteSuccessfully(t *testing.T) *int {
+	requests := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "text/plain")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.CheckEquals(t, "bulk-delete", r.URL.RawQuery)
+
+		b, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		response, ok := DeleteResponses[string(b)]
+		if !ok {
+			t.Fatalf("Unexpected bulk delete request: %q", b)
+		}
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, response)
+	})
+	return &requests
+}
+
+// HandleDeleteFailing creates an HTTP handler at `/` on the test handler mux
+// that responds to the deletion of the first batch of TestDelete with its
+// report, and fails the following requests with a 500.
+func HandleDeleteFailing(t *testing.T) {
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		b, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		if string(b) != "/testContainer/a%20b\n/testContainer/c%C3%BC" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, DeleteResponses[string(b)])
+	})
+}
+
+// HandleExtractSuccessfully creates an HTTP handler at `/testContainer/site`
+// on the test handler mux that responds to an archive extraction with the
+// given report.
+func HandleExtractSuccessfully(t *testing.T, content, response string) {
+	th.Mux.HandleFunc("/testContainer/site", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"extract-archive": "tar.gz"})
+
+		b, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, content, string(b))
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, strings.TrimSpace(response))
+	})
+}
@@ -0,0 +1,107 @@
+package testing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/bulk"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInfoSuccessfully(t, InfoResponse)
+	requests := HandleDeleteSuccessfully(t)
+
+	// The paths are deleted two at a time, as advertised by the cluster.
+	paths := []string{"testContainer/a b", "testContainer/cü", "testContainer"}
+	actual, err := bulk.Delete(fake.ServiceClient(), paths)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, *requests)
+
+	expected := &bulk.DeleteReport{
+		NumberDeleted:  1,
+		NumberNotFound: 1,
+		Errors:         []bulk.ItemError{{Path: "testContainer", Status: "409 Conflict"}},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestDeleteFailedBatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInfoSuccessfully(t, InfoResponse)
+	HandleDeleteFailing(t)
+
+	// The report covers the first batch, deleted before the second one failed.
+	paths := []string{"testContainer/a b", "testContainer/cü", "testContainer"}
+	actual, err := bulk.Delete(fake.ServiceClient(), paths)
+	if _, ok := err.(gophercloud.ErrDefault500); !ok {
+		t.Fatalf("Expected an ErrDefault500, but got %#v", err)
+	}
+	th.CheckDeepEquals(t, &bulk.DeleteReport{NumberDeleted: 1, NumberNotFound: 1}, actual)
+}
+
+func TestDeleteNotSupported(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInfoSuccessfully(t, InfoWithoutBulkResponse)
+
+	_, err := bulk.Delete(fake.ServiceClient(), []string{"testContainer/a"})
+	if _, ok := err.(bulk.ErrNotSupported); !ok {
+		t.Fatalf("Expected an ErrNotSupported, but got %#v", err)
+	}
+}
+
+func TestExtractArchive(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInfoSuccessfully(t, InfoResponse)
+	HandleExtractSuccessfully(t, "archive", ExtractResponse)
+
+	opts := bulk.ExtractOpts{
+		Content: strings.NewReader("archive"),
+		Format:  bulk.TarGz,
+	}
+	actual, err := bulk.ExtractArchive(fake.ServiceClient(), "testContainer/site", opts)
+	th.AssertNoErr(t, err)
+
+	expected := &bulk.ExtractReport{
+		NumberFilesCreated: 2,
+		Errors:             []bulk.ItemError{{Path: "testContainer/site/bad file", Status: "412 Precondition Failed"}},
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestExtractArchiveFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleInfoSuccessfully(t, InfoResponse)
+	HandleExtractSuccessfully(t, "archive", ExtractFailedResponse)
+
+	opts := bulk.ExtractOpts{
+		Content: strings.NewReader("archive"),
+		Format:  bulk.TarGz,
+	}
+	_, err := bulk.ExtractArchive(fake.ServiceClient(), "testContainer/site", opts)
+	e, ok := err.(bulk.ErrBulkFailed)
+	if !ok {
+		t.Fatalf("Expected an ErrBulkFailed, but got %#v", err)
+	}
+	th.CheckEquals(t, "400 Bad Request", e.Status)
+	th.CheckEquals(t, "Invalid Tar File: not a gzip file", e.Body)
+}
+
+func TestExtractArchiveInvalidFormat(t *testing.T) {
+	opts := bulk.ExtractOpts{
+		Content: strings.NewReader("archive"),
+		Format:  "zip",
+	}
+	_, err := bulk.ExtractArchive(fake.ServiceClient(), "testContainer/site", opts)
+	if err == nil {
+		t.Fatalf("Expected an error for an invalid format")
+	}
+}
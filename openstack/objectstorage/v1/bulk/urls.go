@@ -0,0 +1,21 @@
+package bulk
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func deleteURL(c *gophercloud.ServiceClient) string {
+	return c.Endpoint + "?bulk-delete"
+}
+
+func extractURL(c *gophercloud.ServiceClient, uploadPath string, format ArchiveFormat) string {
+	return c.Endpoint + escapePath(uploadPath) + "?extract-archive=" + string(format)
+}
+
+// escapePath escapes each segment of a "container/object" path.
+func escapePath(path string) string {
+	return (&url.URL{Path: strings.TrimPrefix(path, "/")}).EscapedPath()
+}
@@ -64,6 +64,13 @@ type CreateOptsBuilder interface {
 }
 
 // CreateOpts is a structure that holds parameters for creating a container.
+//
+// Object versioning is enabled with one of VersionsLocation, HistoryLocation
+// or VersionsEnabled: the first two name the container the previous versions
+// of the objects are moved to, in the "stack" and "history" modes of the
+// versioned_writes middleware, while VersionsEnabled enables the
+// object_versioning middleware of Swift 2.24 and later, which keeps the
+// versions in a hidden container.
 type CreateOpts struct {
 	Metadata          map[string]string
 	ContainerRead     string `h:"X-Container-Read"`
@@ -74,6 +81,10 @@ type CreateOpts struct {
 	DetectContentType bool   `h:"X-Detect-Content-Type"`
 	IfNoneMatch       string `h:"If-None-Match"`
 	VersionsLocation  string `h:"X-Versions-Location"`
+	HistoryLocation   string `h:"X-History-Location"`
+	VersionsEnabled   *bool  `h:"X-Versions-Enabled"`
+	TempURLKey        string `h:"X-Container-Meta-Temp-URL-Key"`
+	TempURLKey2       string `h:"X-Container-Meta-Temp-URL-Key-2"`
 }
 
 // ToContainerCreateMap formats a CreateOpts into a map of headers.
@@ -126,6 +137,9 @@ type UpdateOptsBuilder interface {
 
 // UpdateOpts is a structure that holds parameters for updating, creating, or
 // deleting a container's metadata.
+//
+// Setting VersionsEnabled to false suspends the versioning of the objects,
+// without deleting their versions.
 type UpdateOpts struct {
 	Metadata               map[string]string
 	ContainerRead          string `h:"X-Container-Read"`
@@ -136,6 +150,11 @@ type UpdateOpts struct {
 	DetectContentType      bool   `h:"X-Detect-Content-Type"`
 	RemoveVersionsLocation string `h:"X-Remove-Versions-Location"`
 	VersionsLocation       string `h:"X-Versions-Location"`
+	RemoveHistoryLocation  string `h:"X-Remove-History-Location"`
+	HistoryLocation        string `h:"X-History-Location"`
+	VersionsEnabled        *bool  `h:"X-Versions-Enabled"`
+	TempURLKey             string `h:"X-Container-Meta-Temp-URL-Key"`
+	TempURLKey2            string `h:"X-Container-Meta-Temp-URL-Key-2"`
 }
 
 // ToContainerUpdateMap formats a CreateOpts into a map of headers.
@@ -97,18 +97,23 @@ type GetHeader struct {
 	Read             []string                `json:"-"`
 	TransID          string                  `json:"X-Trans-Id"`
 	VersionsLocation string                  `json:"X-Versions-Location"`
+	HistoryLocation  string                  `json:"X-History-Location"`
+	VersionsEnabled  bool                    `json:"-"`
 	Write            []string                `json:"-"`
+	TempURLKey       string                  `json:"X-Container-Meta-Temp-URL-Key"`
+	TempURLKey2      string                  `json:"X-Container-Meta-Temp-URL-Key-2"`
 }
 
 func (h *GetHeader) UnmarshalJSON(b []byte) error {
 	type tmp GetHeader
 	var getHeader *struct {
 		tmp
-		BytesUsed     string `json:"X-Container-Bytes-Used"`
-		ContentLength string `json:"Content-Length"`
-		ObjectCount   string `json:"X-Container-Object-Count"`
-		Write         string `json:"X-Container-Write"`
-		Read          string `json:"X-Container-Read"`
+		BytesUsed       string `json:"X-Container-Bytes-Used"`
+		ContentLength   string `json:"Content-Length"`
+		ObjectCount     string `json:"X-Container-Object-Count"`
+		Write           string `json:"X-Container-Write"`
+		Read            string `json:"X-Container-Read"`
+		VersionsEnabled string `json:"X-Versions-Enabled"`
 	}
 	err := json.Unmarshal(b, &getHeader)
 	if err != nil {
@@ -147,6 +152,13 @@ func (h *GetHeader) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if getHeader.VersionsEnabled != "" {
+		h.VersionsEnabled, err = strconv.ParseBool(getHeader.VersionsEnabled)
+		if err != nil {
+			return err
+		}
+	}
+
 	h.Read = strings.Split(getHeader.Read, ",")
 	h.Write = strings.Split(getHeader.Write, ",")
 
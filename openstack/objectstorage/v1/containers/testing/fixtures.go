@@ -152,3 +152,30 @@ func HandleGetContainerSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// HandleVersionedContainerSuccessfully creates an HTTP handler at
+// `/versionedContainer` on the test handler mux that checks the versioning
+// headers of `Create` and `Update` requests, and responds to `Get` requests
+// with them.
+func HandleVersionedContainerSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/versionedContainer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			th.TestHeader(t, r, "X-Versions-Enabled", "true")
+			th.TestHeader(t, r, "X-History-Location", "")
+			w.WriteHeader(http.StatusCreated)
+		case "POST":
+			th.TestHeader(t, r, "X-Versions-Enabled", "false")
+			th.TestHeader(t, r, "X-Remove-History-Location", "true")
+			w.WriteHeader(http.StatusNoContent)
+		case "HEAD":
+			w.Header().Set("X-Versions-Enabled", "True")
+			w.Header().Set("X-History-Location", "archive")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("Unexpected method %s", r.Method)
+		}
+	})
+}
@@ -143,3 +143,22 @@ func TestGetContainer(t *testing.T) {
 	th.CheckNoErr(t, err)
 	th.AssertDeepEquals(t, expected, actual)
 }
+
+func TestContainerVersioning(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleVersionedContainerSuccessfully(t)
+
+	enabled := true
+	res := containers.Create(fake.ServiceClient(), "versionedContainer", containers.CreateOpts{VersionsEnabled: &enabled})
+	th.CheckNoErr(t, res.Err)
+
+	actual, err := containers.Get(fake.ServiceClient(), "versionedContainer").Extract()
+	th.CheckNoErr(t, err)
+	th.CheckEquals(t, true, actual.VersionsEnabled)
+	th.CheckEquals(t, "archive", actual.HistoryLocation)
+
+	disabled := false
+	updateOpts := containers.UpdateOpts{VersionsEnabled: &disabled, RemoveHistoryLocation: "true"}
+	th.CheckNoErr(t, containers.Update(fake.ServiceClient(), "versionedContainer", updateOpts).Err)
+}
@@ -0,0 +1,61 @@
+/*
+Package dirsync mirrors a local directory to the objects of an Object Storage
+container sharing a prefix, or the other way around.
+
+Files are compared with their object by size first. Same-size files are
+assumed unchanged when the object was last modified after the file, for an
+upload, or when the file has the Last-Modified time of the object, which
+downloads set, for a download. Otherwise, the MD5 checksum of the file is
+compared with the ETag of the object. Only the files that differ are
+transferred, a few at a time.
+
+The Large Objects uploaded by setting SegmentSize have an ETag that isn't the
+checksum of their content, so that they're only compared by size and time.
+
+Example to Upload a Directory
+
+	opts := dirsync.Opts{
+		Prefix: "builds/42",
+		Delete: true,
+	}
+
+	report, err := dirsync.Upload(objectStorageClient, "dist", "artifacts", opts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Uploaded %d files, %d bytes\n", len(report.Transferred), report.Bytes)
+	for _, e := range report.Errors {
+		fmt.Println(e)
+	}
+
+Example to Download a Directory
+
+	opts := dirsync.Opts{
+		Prefix:      "builds/42",
+		Concurrency: 8,
+	}
+
+	report, err := dirsync.Download(objectStorageClient, "artifacts", "dist", opts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Downloaded %d files, %d were unchanged\n", len(report.Transferred), len(report.Skipped))
+
+Example to Preview a Synchronization
+
+	opts := dirsync.Opts{
+		Prefix: "builds/42",
+		Delete: true,
+		DryRun: true,
+	}
+
+	report, err := dirsync.Upload(objectStorageClient, "dist", "artifacts", opts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Would upload %v and delete %v\n", report.Transferred, report.Deleted)
+*/
+package dirsync
@@ -0,0 +1,30 @@
+package dirsync
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrChecksum is the error when the content of a downloaded object doesn't
+// match its ETag.
+type ErrChecksum struct {
+	gophercloud.BaseError
+	Expected string
+	Actual   string
+}
+
+func (e ErrChecksum) Error() string {
+	return fmt.Sprintf("Checksum of the content is %s, but the ETag is %s", e.Actual, e.Expected)
+}
+
+// ErrUnsafeName is the error when the name of an object would be downloaded
+// outside of the directory, or isn't a valid file name.
+type ErrUnsafeName struct {
+	gophercloud.BaseError
+	Name string
+}
+
+func (e ErrUnsafeName) Error() string {
+	return fmt.Sprintf("Object [%s] can't be downloaded in the directory", e.Name)
+}
@@ -0,0 +1,461 @@
+package dirsync
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/largeobjects"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+// DefaultConcurrency is the number of files transferred at once when Opts
+// doesn't set one.
+const DefaultConcurrency = 4
+
+// Opts is a structure that holds parameters for synchronizing a directory
+// with a container.
+type Opts struct {
+	// Prefix is the prefix of the names of the objects mirroring the
+	// directory, to which a "/" is appended when missing. When empty, the
+	// directory mirrors the whole container.
+	Prefix string
+
+	// Concurrency is the number of files transferred at once. It defaults to
+	// DefaultConcurrency.
+	Concurrency int
+
+	// Delete deletes the objects, or the files, that don't exist at the
+	// source.
+	Delete bool
+
+	// Checksum compares the MD5 checksum of every file with the ETag of its
+	// object when they have the same size, even when their times say the file
+	// is unchanged. The ETag of a large object isn't the checksum of its
+	// content, so large objects are still compared by size and time only.
+	Checksum bool
+
+	// SegmentSize is the size above which files are uploaded as Static Large
+	// Objects, in segments of that size. When zero, every file is uploaded as
+	// a single object, which is limited to 5 GiB and held in memory while
+	// it's uploaded.
+	SegmentSize int64
+
+	// DryRun reports what the synchronization would do, without transferring
+	// or deleting anything.
+	DryRun bool
+}
+
+// Upload mirrors the files of the local directory dir to the objects of a
+// container whose name starts with opts.Prefix. Files that couldn't be
+// uploaded, or objects that couldn't be deleted, are listed in the Errors of
+// the report, instead of failing the whole synchronization. An error is
+// returned when the directory or the container can't be listed.
+func Upload(c *gophercloud.ServiceClient, dir, containerName string, opts Opts) (*Report, error) {
+	opts = withDefaults(opts)
+
+	local, err := listFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := listObjects(c, containerName, opts.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &reporter{}
+	p := newPool(c, opts.Concurrency)
+	for _, name := range sortedNames(local) {
+		name, f := name, local[name]
+		o, exists := remote[name]
+		p.run(func() {
+			if exists {
+				upToDate := !time.Time(o.LastModified).Before(f.modTime)
+				same, err := unchanged(c, containerName, opts.Prefix+name, f, o, upToDate, opts.Checksum)
+				if err != nil {
+					r.failed(name, err)
+					return
+				}
+				if same {
+					r.skipped(name)
+					return
+				}
+			}
+
+			if !opts.DryRun {
+				if err := uploadFile(c, containerName, opts.Prefix+name, f, opts.SegmentSize); err != nil {
+					r.failed(name, err)
+					return
+				}
+			}
+			r.transferred(name, f.size)
+		})
+	}
+
+	if opts.Delete {
+		for _, name := range sortedNames(remote) {
+			if _, ok := local[name]; ok {
+				continue
+			}
+			name := name
+			p.run(func() {
+				if !opts.DryRun {
+					if err := deleteObject(c, containerName, opts.Prefix+name); err != nil {
+						r.failed(name, err)
+						return
+					}
+				}
+				r.deleted(name)
+			})
+		}
+	}
+
+	if err := p.wait(); err != nil {
+		return nil, err
+	}
+	return r.sorted(), nil
+}
+
+// Download mirrors the objects of a container whose name starts with
+// opts.Prefix to the local directory dir, which is created if needed. The
+// files downloaded get the Last-Modified time of their object. Objects that
+// couldn't be downloaded, or files that couldn't be deleted, are listed in the
+// Errors of the report, instead of failing the whole synchronization. An error
+// is returned when the directory or the container can't be listed.
+//
+// The objects whose name would escape the directory, like "../etc/passwd",
+// fail with an ErrUnsafeName error. The objects whose name ends with a "/",
+// that mark pseudo-directories, are ignored.
+func Download(c *gophercloud.ServiceClient, containerName, dir string, opts Opts) (*Report, error) {
+	opts = withDefaults(opts)
+
+	local, err := listFiles(dir)
+	if os.IsNotExist(err) {
+		local, err = map[string]localFile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	remote, err := listObjects(c, containerName, opts.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &reporter{}
+	p := newPool(c, opts.Concurrency)
+	for _, name := range sortedNames(remote) {
+		name, o := name, remote[name]
+		f, exists := local[name]
+		p.run(func() {
+			path, err := localPath(dir, name)
+			if err != nil {
+				r.failed(name, err)
+				return
+			}
+
+			lastModified := time.Time(o.LastModified)
+			if exists {
+				upToDate := f.modTime.Truncate(time.Second).Equal(lastModified.Truncate(time.Second))
+				same, err := unchanged(c, containerName, opts.Prefix+name, f, o, upToDate, opts.Checksum)
+				if err != nil {
+					r.failed(name, err)
+					return
+				}
+				if same {
+					// Give the file the time of its object, so that it's found up
+					// to date without being checksummed next time.
+					if !upToDate && !opts.DryRun {
+						if err := os.Chtimes(path, lastModified, lastModified); err != nil {
+							r.failed(name, err)
+							return
+						}
+					}
+					r.skipped(name)
+					return
+				}
+			}
+
+			n := o.Bytes
+			if !opts.DryRun {
+				n, err = downloadFile(c, containerName, opts.Prefix+name, path, lastModified)
+				if err != nil {
+					r.failed(name, err)
+					return
+				}
+			}
+			r.transferred(name, n)
+		})
+	}
+
+	if opts.Delete {
+		for _, name := range sortedNames(local) {
+			if _, ok := remote[name]; ok {
+				continue
+			}
+			name, f := name, local[name]
+			p.run(func() {
+				if !opts.DryRun {
+					if err := os.Remove(f.path); err != nil {
+						r.failed(name, err)
+						return
+					}
+				}
+				r.deleted(name)
+			})
+		}
+	}
+
+	if err := p.wait(); err != nil {
+		return nil, err
+	}
+	return r.sorted(), nil
+}
+
+// withDefaults returns opts with the defaults of the options left unset.
+func withDefaults(opts Opts) Opts {
+	if opts.Prefix != "" && !strings.HasSuffix(opts.Prefix, "/") {
+		opts.Prefix += "/"
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultConcurrency
+	}
+	return opts
+}
+
+// localFile is a regular file of the synchronized directory.
+type localFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// listFiles returns the regular files under dir, by path relative to it in
+// the slash form.
+func listFiles(dir string) (map[string]localFile, error) {
+	files := make(map[string]localFile)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(rel)] = localFile{path: path, size: info.Size(), modTime: info.ModTime()}
+		return nil
+	})
+	return files, err
+}
+
+// listObjects returns the objects of a container whose name starts with
+// prefix, by name without the prefix.
+func listObjects(c *gophercloud.ServiceClient, containerName, prefix string) (map[string]objects.Object, error) {
+	all, err := objects.ListAll(c, containerName, objects.ListOpts{Prefix: prefix})
+	if err != nil {
+		return nil, err
+	}
+
+	listed := make(map[string]objects.Object, len(all))
+	for _, o := range all {
+		name := strings.TrimPrefix(o.Name, prefix)
+		if name == "" || strings.HasSuffix(name, "/") {
+			continue
+		}
+		listed[name] = o
+	}
+	return listed, nil
+}
+
+// sortedNames returns the keys of m, sorted.
+func sortedNames[T any](m map[string]T) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// unchanged tells whether the file f has the content of the object o, named
+// objectName. Files of the same size are assumed unchanged when upToDate says
+// their times match, unless checksum is set, and compared by MD5 checksum
+// otherwise. Large objects, whose ETag isn't the checksum of their content,
+// are never compared by checksum.
+func unchanged(c *gophercloud.ServiceClient, containerName, objectName string, f localFile, o objects.Object, upToDate, checksum bool) (bool, error) {
+	if f.size != o.Bytes {
+		return false, nil
+	}
+	if upToDate && !checksum {
+		return true, nil
+	}
+
+	file, err := os.Open(f.path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return false, err
+	}
+	if fmt.Sprintf("%x", hash.Sum(nil)) == o.Hash {
+		return true, nil
+	}
+
+	// The listing doesn't tell large objects apart, so look the object up
+	// only when the checksums differ.
+	h, err := objects.Get(c, containerName, objectName, nil).Extract()
+	if err != nil {
+		return false, err
+	}
+	if h.StaticLargeObject || h.ObjectManifest != "" {
+		return upToDate, nil
+	}
+	return false, nil
+}
+
+// uploadFile uploads the file f as an object, or as a Static Large Object
+// when it's larger than a non-zero segmentSize.
+func uploadFile(c *gophercloud.ServiceClient, containerName, objectName string, f localFile, segmentSize int64) error {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if segmentSize > 0 && f.size > segmentSize {
+		_, err := largeobjects.Create(c, containerName, objectName, largeobjects.CreateOpts{
+			Content:     file,
+			SegmentSize: segmentSize,
+		})
+		return err
+	}
+	return objects.Create(c, containerName, objectName, objects.CreateOpts{Content: file}).Err
+}
+
+// deleteObject deletes an object, along with its segments when it's a large
+// object.
+func deleteObject(c *gophercloud.ServiceClient, containerName, objectName string) error {
+	err := largeobjects.Delete(c, containerName, objectName)
+	if _, ok := err.(largeobjects.ErrNotLargeObject); ok {
+		err = objects.Delete(c, containerName, objectName, nil).Err
+	}
+	if _, ok := err.(gophercloud.ErrDefault404); ok {
+		return nil
+	}
+	return err
+}
+
+// localPath returns the path of the file an object named name is downloaded
+// to, under dir.
+func localPath(dir, name string) (string, error) {
+	for _, segment := range strings.Split(name, "/") {
+		if segment == "" || segment == "." || segment == ".." || strings.ContainsRune(segment, 0) ||
+			strings.ContainsRune(segment, filepath.Separator) {
+			return "", ErrUnsafeName{Name: name}
+		}
+	}
+	return filepath.Join(dir, filepath.FromSlash(name)), nil
+}
+
+// downloadFile downloads an object to path, through a temporary file renamed
+// once the content is checked, and gives it the time lastModified. It returns
+// the number of bytes downloaded.
+func downloadFile(c *gophercloud.ServiceClient, containerName, objectName, path string, lastModified time.Time) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return 0, err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+
+	r := objects.Download(c, containerName, objectName, nil)
+	if r.Err != nil {
+		tmp.Close()
+		return 0, r.Err
+	}
+	defer r.Body.Close()
+
+	hash := md5.New()
+	n, err := io.Copy(io.MultiWriter(tmp, hash), r.Body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	// The ETag of a large object is not the checksum of its content.
+	if r.Header.Get("X-Static-Large-Object") == "" && r.Header.Get("X-Object-Manifest") == "" {
+		etag := strings.Trim(r.Header.Get("Etag"), `"`)
+		if sum := fmt.Sprintf("%x", hash.Sum(nil)); sum != etag {
+			return 0, ErrChecksum{Expected: etag, Actual: sum}
+		}
+	}
+
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return 0, err
+	}
+	if err := os.Chtimes(tmp.Name(), lastModified, lastModified); err != nil {
+		return 0, err
+	}
+	return n, os.Rename(tmp.Name(), path)
+}
+
+// pool runs tasks, concurrency at a time, until the context of the client is
+// done.
+type pool struct {
+	ctx   context.Context
+	wg    sync.WaitGroup
+	slots chan struct{}
+}
+
+func newPool(c *gophercloud.ServiceClient, concurrency int) *pool {
+	return &pool{ctx: c.ContextOrBackground(), slots: make(chan struct{}, concurrency)}
+}
+
+// run runs task once a slot is free. The task is dropped when the context is
+// done first.
+func (p *pool) run(task func()) {
+	select {
+	case p.slots <- struct{}{}:
+	case <-p.ctx.Done():
+		return
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		defer func() { <-p.slots }()
+		task()
+	}()
+}
+
+// wait waits for the tasks to be done, and returns the error of the context
+// when it's done.
+func (p *pool) wait() error {
+	p.wg.Wait()
+	return p.ctx.Err()
+}
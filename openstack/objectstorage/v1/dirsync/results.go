@@ -0,0 +1,80 @@
+package dirsync
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Report is the report of a synchronization. The paths it lists are relative
+// to the directory, with "/" separators, which is also the name of their
+// object without the prefix.
+type Report struct {
+	// Transferred are the files uploaded or downloaded.
+	Transferred []string
+
+	// Skipped are the files found unchanged.
+	Skipped []string
+
+	// Deleted are the objects, or the files, deleted since they didn't exist
+	// at the source.
+	Deleted []string
+
+	// Bytes is the number of bytes transferred.
+	Bytes int64
+
+	// Errors are the files that couldn't be synchronized.
+	Errors []FileError
+}
+
+// FileError is the error that prevented a file from being synchronized.
+type FileError struct {
+	Path string
+	Err  error
+}
+
+func (e FileError) Error() string {
+	return fmt.Sprintf("Unable to synchronize [%s]: %s", e.Path, e.Err)
+}
+
+// reporter fills a Report from concurrent workers.
+type reporter struct {
+	mu     sync.Mutex
+	report Report
+}
+
+func (r *reporter) transferred(path string, n int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.report.Transferred = append(r.report.Transferred, path)
+	r.report.Bytes += n
+}
+
+func (r *reporter) skipped(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.report.Skipped = append(r.report.Skipped, path)
+}
+
+func (r *reporter) deleted(path string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.report.Deleted = append(r.report.Deleted, path)
+}
+
+func (r *reporter) failed(path string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.report.Errors = append(r.report.Errors, FileError{Path: path, Err: err})
+}
+
+// sorted returns the report, with its paths sorted.
+func (r *reporter) sorted() *Report {
+	sort.Strings(r.report.Transferred)
+	sort.Strings(r.report.Skipped)
+	sort.Strings(r.report.Deleted)
+	sort.Slice(r.report.Errors, func(i, j int) bool {
+		return r.report.Errors[i].Path < r.report.Errors[j].Path
+	})
+	return &r.report
+}
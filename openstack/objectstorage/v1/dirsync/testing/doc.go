@@ -0,0 +1,2 @@
+// objectstorage_dirsync_v1
+package testing
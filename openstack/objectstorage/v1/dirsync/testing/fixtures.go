@@ -0,0 +1,126 @@
+package testing
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// Object is an object of the container served by HandleContainerSuccessfully.
+type Object struct {
+	Content      string
+	LastModified time.Time
+
+	// LargeObject makes the object a Static Large Object, whose ETag isn't
+	// the checksum of its content.
+	LargeObject bool
+}
+
+// etag returns the ETag of the object.
+func (o Object) etag() string {
+	if o.LargeObject {
+		return fmt.Sprintf("%x", md5.Sum([]byte("segments of "+o.Content)))
+	}
+	return fmt.Sprintf("%x", md5.Sum([]byte(o.Content)))
+}
+
+// Container is the content of the container served by
+// HandleContainerSuccessfully, and the requests made to it.
+type Container struct {
+	mu       sync.Mutex
+	Objects  map[string]Object
+	Uploaded []string
+	Deleted  []string
+}
+
+// HandleContainerSuccessfully creates HTTP handlers at `/testContainer` and
+// under `/testContainer/` on the test handler mux that list, upload, download
+// and delete the objects of a container holding the given objects.
+func HandleContainerSuccessfully(t *testing.T, objects map[string]Object) *Container {
+	c := &Container{Objects: objects}
+
+	th.Mux.HandleFunc("/testContainer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		r.ParseForm()
+		w.Header().Set("Content-Type", "application/json")
+		if r.Form.Get("marker") != "" {
+			fmt.Fprint(w, "[]")
+			return
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		listed := []map[string]interface{}{}
+		for name, o := range c.Objects {
+			if !strings.HasPrefix(name, r.Form.Get("prefix")) {
+				continue
+			}
+			listed = append(listed, map[string]interface{}{
+				"name":          name,
+				"bytes":         len(o.Content),
+				"hash":          o.etag(),
+				"last_modified": o.LastModified.UTC().Format("2006-01-02T15:04:05.000000"),
+				"content_type":  "application/octet-stream",
+			})
+		}
+		sort.Slice(listed, func(i, j int) bool { return listed[i]["name"].(string) < listed[j]["name"].(string) })
+		th.AssertNoErr(t, json.NewEncoder(w).Encode(listed))
+	})
+
+	th.Mux.HandleFunc("/testContainer/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		name := strings.TrimPrefix(r.URL.Path, "/testContainer/")
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		o, exists := c.Objects[name]
+
+		switch r.Method {
+		case "PUT":
+			b, err := ioutil.ReadAll(r.Body)
+			th.AssertNoErr(t, err)
+			c.Objects[name] = Object{Content: string(b), LastModified: time.Now()}
+			c.Uploaded = append(c.Uploaded, name)
+			w.Header().Set("ETag", fmt.Sprintf("%x", md5.Sum(b)))
+			w.WriteHeader(http.StatusCreated)
+		case "HEAD", "GET":
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("ETag", o.etag())
+			if o.LargeObject {
+				w.Header().Set("X-Static-Large-Object", "True")
+			}
+			w.Header().Set("Last-Modified", o.LastModified.UTC().Format(http.TimeFormat))
+			w.WriteHeader(http.StatusOK)
+			if r.Method == "GET" {
+				fmt.Fprint(w, o.Content)
+			}
+		case "DELETE":
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(c.Objects, name)
+			c.Deleted = append(c.Deleted, name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("Unexpected method %s", r.Method)
+		}
+	})
+
+	return c
+}
@@ -0,0 +1,177 @@
+package testing
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/dirsync"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+var (
+	past   = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	future = time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+)
+
+// writeFiles writes files under dir, by path relative to it, with the
+// modification time modTime.
+func writeFiles(t *testing.T, dir string, files map[string]string, modTime time.Time) {
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		th.AssertNoErr(t, os.MkdirAll(filepath.Dir(path), 0755))
+		th.AssertNoErr(t, ioutil.WriteFile(path, []byte(content), 0644))
+		th.AssertNoErr(t, os.Chtimes(path, modTime, modTime))
+	}
+}
+
+func TestUpload(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	container := HandleContainerSuccessfully(t, map[string]Object{
+		// Modified after the file, so that it's found up to date.
+		"site/index.html": {Content: "<html>", LastModified: future},
+		// Modified before the file, with the same size but another content.
+		"site/app.js": {Content: "old()", LastModified: past},
+		// Modified before the file, but with the same content.
+		"site/app.css": {Content: "body{}", LastModified: past},
+		"site/old.txt": {Content: "gone", LastModified: past},
+		"other.txt":    {Content: "kept", LastModified: past},
+	})
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"index.html":   "<html>",
+		"app.js":       "new()",
+		"app.css":      "body{}",
+		"img/logo.svg": "<svg/>",
+	}, time.Now().Add(-time.Minute))
+
+	report, err := dirsync.Upload(fake.ServiceClient(), dir, "testContainer", dirsync.Opts{Prefix: "site", Delete: true})
+	th.AssertNoErr(t, err)
+
+	expected := &dirsync.Report{
+		Transferred: []string{"app.js", "img/logo.svg"},
+		Skipped:     []string{"app.css", "index.html"},
+		Deleted:     []string{"old.txt"},
+		Bytes:       11,
+	}
+	th.CheckDeepEquals(t, expected, report)
+	th.CheckEquals(t, "new()", container.Objects["site/app.js"].Content)
+	th.CheckEquals(t, "<svg/>", container.Objects["site/img/logo.svg"].Content)
+	th.CheckEquals(t, "kept", container.Objects["other.txt"].Content)
+}
+
+func TestUploadChecksum(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	container := HandleContainerSuccessfully(t, map[string]Object{
+		"index.html": {Content: "<html>", LastModified: future},
+	})
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"index.html": "<body>"}, past)
+
+	report, err := dirsync.Upload(fake.ServiceClient(), dir, "testContainer", dirsync.Opts{Checksum: true})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"index.html"}, report.Transferred)
+	th.CheckEquals(t, "<body>", container.Objects["index.html"].Content)
+}
+
+func TestUploadChecksumLargeObject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	container := HandleContainerSuccessfully(t, map[string]Object{
+		"up-to-date.bin": {Content: "same", LastModified: future, LargeObject: true},
+		"outdated.bin":   {Content: "same", LastModified: past, LargeObject: true},
+	})
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"up-to-date.bin": "same", "outdated.bin": "same"}, time.Now().Add(-time.Minute))
+
+	report, err := dirsync.Upload(fake.ServiceClient(), dir, "testContainer", dirsync.Opts{Checksum: true})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"outdated.bin"}, report.Transferred)
+	th.CheckDeepEquals(t, []string{"up-to-date.bin"}, report.Skipped)
+	th.CheckDeepEquals(t, []string{"outdated.bin"}, container.Uploaded)
+}
+
+func TestUploadDryRun(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	container := HandleContainerSuccessfully(t, map[string]Object{
+		"site/old.txt": {Content: "gone", LastModified: past},
+	})
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{"index.html": "<html>"}, past)
+
+	report, err := dirsync.Upload(fake.ServiceClient(), dir, "testContainer", dirsync.Opts{Prefix: "site/", Delete: true, DryRun: true})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"index.html"}, report.Transferred)
+	th.CheckDeepEquals(t, []string{"old.txt"}, report.Deleted)
+	th.CheckEquals(t, 0, len(container.Uploaded))
+	th.CheckEquals(t, 0, len(container.Deleted))
+}
+
+func TestDownload(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleContainerSuccessfully(t, map[string]Object{
+		"site/index.html":   {Content: "<html>", LastModified: past},
+		"site/app.js":       {Content: "new()", LastModified: past},
+		"site/img/logo.svg": {Content: "<svg/>", LastModified: past},
+		"site/img/":         {Content: "", LastModified: past},
+		"other.txt":         {Content: "ignored", LastModified: past},
+	})
+
+	dir := t.TempDir()
+	// index.html has the time of its object, and app.js another content.
+	writeFiles(t, dir, map[string]string{"index.html": "<html>"}, past)
+	writeFiles(t, dir, map[string]string{"app.js": "old()", "old.txt": "gone"}, time.Now())
+
+	report, err := dirsync.Download(fake.ServiceClient(), "testContainer", dir, dirsync.Opts{Prefix: "site", Delete: true})
+	th.AssertNoErr(t, err)
+
+	expected := &dirsync.Report{
+		Transferred: []string{"app.js", "img/logo.svg"},
+		Skipped:     []string{"index.html"},
+		Deleted:     []string{"old.txt"},
+		Bytes:       11,
+	}
+	th.CheckDeepEquals(t, expected, report)
+
+	b, err := ioutil.ReadFile(filepath.Join(dir, "img", "logo.svg"))
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "<svg/>", string(b))
+
+	info, err := os.Stat(filepath.Join(dir, "app.js"))
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, info.ModTime().Equal(past))
+
+	_, err = os.Stat(filepath.Join(dir, "old.txt"))
+	th.CheckEquals(t, true, os.IsNotExist(err))
+}
+
+func TestDownloadUnsafeName(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleContainerSuccessfully(t, map[string]Object{
+		"site/../escaped": {Content: "nope", LastModified: past},
+	})
+
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "site")
+	report, err := dirsync.Download(fake.ServiceClient(), "testContainer", dir, dirsync.Opts{Prefix: "site"})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, len(report.Errors))
+	if _, ok := report.Errors[0].Err.(dirsync.ErrUnsafeName); !ok {
+		t.Fatalf("Expected an ErrUnsafeName, but got %#v", report.Errors[0].Err)
+	}
+
+	_, err = os.Stat(filepath.Join(parent, "escaped"))
+	th.CheckEquals(t, true, os.IsNotExist(err))
+}
@@ -0,0 +1,23 @@
+/*
+Package info retrieves the capabilities of an Object Storage cluster, from its
+/info document: the limits of the cluster, and the middleware it runs along
+with their own limits.
+
+Example to Get the Capabilities of a Cluster
+
+	capabilities, err := info.Get(objectStorageClient).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Swift %s, max file size %d\n", capabilities.Swift.Version, capabilities.Swift.MaxFileSize)
+
+	if capabilities.TempURL != nil {
+		fmt.Printf("Temp URL digests: %v\n", capabilities.TempURL.AllowedDigests)
+	}
+
+	if capabilities.Has("symlink") {
+		fmt.Println("Symlinks are supported")
+	}
+*/
+package info
@@ -0,0 +1,14 @@
+package info
+
+import "github.com/gophercloud/gophercloud"
+
+// Get retrieves the capabilities of the cluster. To extract them, call the
+// Extract method on the GetResult.
+func Get(c *gophercloud.ServiceClient) (r GetResult) {
+	resp, err := c.Get(getURL(c), &r.Body, nil)
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	r.Err = err
+	return
+}
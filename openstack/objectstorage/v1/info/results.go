@@ -0,0 +1,202 @@
+package info
+
+import (
+	"encoding/json"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// Capabilities are the capabilities of an Object Storage cluster. The
+// middleware the cluster doesn't run are left nil.
+type Capabilities struct {
+	// Swift holds the limits of the cluster itself.
+	Swift Swift `json:"swift"`
+
+	// SLO holds the limits of the Static Large Object middleware.
+	SLO *SLO `json:"slo"`
+
+	// BulkDelete holds the limits of bulk deletes.
+	BulkDelete *BulkDelete `json:"bulk_delete"`
+
+	// BulkUpload holds the limits of archive extractions.
+	BulkUpload *BulkUpload `json:"bulk_upload"`
+
+	// TempURL holds the settings of the temporary URL middleware.
+	TempURL *TempURL `json:"tempurl"`
+
+	// Symlink holds the settings of the symlink middleware.
+	Symlink *Symlink `json:"symlink"`
+
+	// Raw holds the capabilities of every middleware, by name, including
+	// those not typed above.
+	Raw map[string]json.RawMessage `json:"-"`
+}
+
+// Has tells whether the cluster advertises a capability, like "symlink" or
+// "versioned_writes".
+func (c Capabilities) Has(name string) bool {
+	_, ok := c.Raw[name]
+	return ok
+}
+
+// Swift holds the limits of the cluster.
+type Swift struct {
+	// Version is the version of Swift the cluster runs.
+	Version string `json:"version"`
+
+	// MaxFileSize is the maximum size of an object, in bytes.
+	MaxFileSize int64 `json:"max_file_size"`
+
+	// MaxObjectNameLength is the maximum length of the name of an object.
+	MaxObjectNameLength int `json:"max_object_name_length"`
+
+	// MaxContainerNameLength is the maximum length of the name of a container.
+	MaxContainerNameLength int `json:"max_container_name_length"`
+
+	// MaxAccountNameLength is the maximum length of the name of an account.
+	MaxAccountNameLength int `json:"max_account_name_length"`
+
+	// MaxMetaCount is the maximum number of metadata items per resource.
+	MaxMetaCount int `json:"max_meta_count"`
+
+	// MaxMetaNameLength is the maximum length of the name of a metadata item.
+	MaxMetaNameLength int `json:"max_meta_name_length"`
+
+	// MaxMetaValueLength is the maximum length of the value of a metadata item.
+	MaxMetaValueLength int `json:"max_meta_value_length"`
+
+	// MaxMetaOverallSize is the maximum overall size of the metadata of a
+	// resource, in bytes.
+	MaxMetaOverallSize int `json:"max_meta_overall_size"`
+
+	// MaxHeaderSize is the maximum size of a header, in bytes.
+	MaxHeaderSize int `json:"max_header_size"`
+
+	// ContainerListingLimit is the maximum number of objects listed per page.
+	ContainerListingLimit int `json:"container_listing_limit"`
+
+	// AccountListingLimit is the maximum number of containers listed per page.
+	AccountListingLimit int `json:"account_listing_limit"`
+
+	// StrictCORSMode tells whether the cluster applies CORS strictly.
+	StrictCORSMode bool `json:"strict_cors_mode"`
+
+	// Policies are the storage policies of the cluster.
+	Policies []Policy `json:"policies"`
+}
+
+// Policy is a storage policy.
+type Policy struct {
+	// Name is the name of the policy.
+	Name string `json:"name"`
+
+	// Aliases are the names of the policy, comma separated.
+	Aliases string `json:"aliases"`
+
+	// Default tells whether the policy is the default one.
+	Default bool `json:"default"`
+}
+
+// SLO holds the limits of the Static Large Object middleware.
+type SLO struct {
+	// MaxManifestSegments is the maximum number of segments of a manifest.
+	MaxManifestSegments int `json:"max_manifest_segments"`
+
+	// MaxManifestSize is the maximum size of a manifest, in bytes.
+	MaxManifestSize int `json:"max_manifest_size"`
+
+	// MinSegmentSize is the minimum size of the segments but the last, in
+	// bytes.
+	MinSegmentSize int64 `json:"min_segment_size"`
+}
+
+// BulkDelete holds the limits of bulk deletes.
+type BulkDelete struct {
+	// MaxDeletesPerRequest is the maximum number of objects deleted per
+	// request.
+	MaxDeletesPerRequest int `json:"max_deletes_per_request"`
+
+	// MaxFailedDeletes is the number of failed deletes after which a request
+	// stops.
+	MaxFailedDeletes int `json:"max_failed_deletes"`
+}
+
+// BulkUpload holds the limits of archive extractions.
+type BulkUpload struct {
+	// MaxContainersPerExtraction is the maximum number of containers an
+	// archive extracted into an account may create.
+	MaxContainersPerExtraction int `json:"max_containers_per_extraction"`
+
+	// MaxFailedExtractions is the number of failed extractions after which an
+	// extraction stops.
+	MaxFailedExtractions int `json:"max_failed_extractions"`
+}
+
+// TempURL holds the settings of the temporary URL middleware.
+type TempURL struct {
+	// Methods are the HTTP methods temporary URLs may be created for.
+	Methods []string `json:"methods"`
+
+	// AllowedDigests are the digests temporary URLs may be signed with, like
+	// "sha1" or "sha256".
+	AllowedDigests []string `json:"allowed_digests"`
+
+	// IncomingRemoveHeaders and IncomingAllowHeaders are the headers removed
+	// from the requests made through a temporary URL, and the exceptions.
+	IncomingRemoveHeaders []string `json:"incoming_remove_headers"`
+	IncomingAllowHeaders  []string `json:"incoming_allow_headers"`
+
+	// OutgoingRemoveHeaders and OutgoingAllowHeaders are the headers removed
+	// from the responses to requests made through a temporary URL, and the
+	// exceptions.
+	OutgoingRemoveHeaders []string `json:"outgoing_remove_headers"`
+	OutgoingAllowHeaders  []string `json:"outgoing_allow_headers"`
+}
+
+// AllowsMethod tells whether temporary URLs may be created for method.
+func (t TempURL) AllowsMethod(method string) bool {
+	return contains(t.Methods, method)
+}
+
+// AllowsDigest tells whether temporary URLs may be signed with digest. Only
+// "sha1" is assumed allowed when the cluster doesn't list the digests, as
+// releases older than 2.22 do.
+func (t TempURL) AllowsDigest(digest string) bool {
+	if t.AllowedDigests == nil {
+		return digest == "sha1"
+	}
+	return contains(t.AllowedDigests, digest)
+}
+
+// Symlink holds the settings of the symlink middleware.
+type Symlink struct {
+	// SymloopMax is the maximum number of symlinks followed to resolve one.
+	SymloopMax int `json:"symloop_max"`
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// GetResult is the result of a Get request. Call its Extract method to
+// interpret it as Capabilities.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as Capabilities.
+func (r GetResult) Extract() (*Capabilities, error) {
+	var s Capabilities
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.ExtractInto(&s.Raw)
+	return &s, err
+}
@@ -0,0 +1,2 @@
+// objectstorage_info_v1
+package testing
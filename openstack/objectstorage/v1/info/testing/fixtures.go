@@ -0,0 +1,121 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/info"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// GetResponse is a sample response to a Get request.
+const GetResponse = `
+{
+  "swift": {
+    "version": "2.23.1",
+    "max_file_size": 5368709122,
+    "max_meta_name_length": 128,
+    "max_meta_value_length": 256,
+    "max_meta_count": 90,
+    "max_meta_overall_size": 4096,
+    "max_header_size": 8192,
+    "max_object_name_length": 1024,
+    "max_container_name_length": 256,
+    "max_account_name_length": 256,
+    "container_listing_limit": 10000,
+    "account_listing_limit": 10000,
+    "strict_cors_mode": true,
+    "policies": [
+      {"name": "gold", "aliases": "gold, silver", "default": true},
+      {"name": "ec42", "aliases": "ec42"}
+    ]
+  },
+  "slo": {
+    "max_manifest_segments": 1000,
+    "max_manifest_size": 8388608,
+    "min_segment_size": 1
+  },
+  "bulk_delete": {
+    "max_failed_deletes": 1000,
+    "max_deletes_per_request": 10000
+  },
+  "bulk_upload": {
+    "max_failed_extractions": 1000,
+    "max_containers_per_extraction": 10000
+  },
+  "tempurl": {
+    "methods": ["GET", "HEAD", "PUT", "POST", "DELETE"],
+    "allowed_digests": ["sha1", "sha256", "sha512"],
+    "incoming_remove_headers": ["x-timestamp"],
+    "incoming_allow_headers": [],
+    "outgoing_remove_headers": ["x-object-meta-*"],
+    "outgoing_allow_headers": ["x-object-meta-public-*"]
+  },
+  "symlink": {
+    "symloop_max": 2
+  },
+  "versioned_writes": {
+    "allowed_flags": ["x-versions-location", "x-history-location"]
+  }
+}
+`
+
+// ExpectedCapabilities are the capabilities of GetResponse, but for Raw.
+var ExpectedCapabilities = info.Capabilities{
+	Swift: info.Swift{
+		Version:                "2.23.1",
+		MaxFileSize:            5368709122,
+		MaxObjectNameLength:    1024,
+		MaxContainerNameLength: 256,
+		MaxAccountNameLength:   256,
+		MaxMetaCount:           90,
+		MaxMetaNameLength:      128,
+		MaxMetaValueLength:     256,
+		MaxMetaOverallSize:     4096,
+		MaxHeaderSize:          8192,
+		ContainerListingLimit:  10000,
+		AccountListingLimit:    10000,
+		StrictCORSMode:         true,
+		Policies: []info.Policy{
+			{Name: "gold", Aliases: "gold, silver", Default: true},
+			{Name: "ec42", Aliases: "ec42"},
+		},
+	},
+	SLO: &info.SLO{
+		MaxManifestSegments: 1000,
+		MaxManifestSize:     8388608,
+		MinSegmentSize:      1,
+	},
+	BulkDelete: &info.BulkDelete{
+		MaxDeletesPerRequest: 10000,
+		MaxFailedDeletes:     1000,
+	},
+	BulkUpload: &info.BulkUpload{
+		MaxContainersPerExtraction: 10000,
+		MaxFailedExtractions:       1000,
+	},
+	TempURL: &info.TempURL{
+		Methods:               []string{"GET", "HEAD", "PUT", "POST", "DELETE"},
+		AllowedDigests:        []string{"sha1", "sha256", "sha512"},
+		IncomingRemoveHeaders: []string{"x-timestamp"},
+		IncomingAllowHeaders:  []string{},
+		OutgoingRemoveHeaders: []string{"x-object-meta-*"},
+		OutgoingAllowHeaders:  []string{"x-object-meta-public-*"},
+	},
+	Symlink: &info.Symlink{
+		SymloopMax: 2,
+	},
+}
+
+// HandleGetSuccessfully creates an HTTP handler at `/info` on the test
+// handler mux that responds with a `Get` response.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/info", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, GetResponse)
+	})
+}
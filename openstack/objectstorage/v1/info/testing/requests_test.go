@@ -0,0 +1,51 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/info"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := info.Get(fake.ServiceClient()).Extract()
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, true, actual.Has("versioned_writes"))
+	th.CheckEquals(t, false, actual.Has("container_sync"))
+
+	actual.Raw = nil
+	th.CheckDeepEquals(t, ExpectedCapabilities, *actual)
+}
+
+func TestGetFromVersionedEndpoint(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	// The document is served above the version and the account.
+	client := fake.ServiceClient()
+	client.Endpoint += "v1/AUTH_test/"
+	actual, err := info.Get(client).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2.23.1", actual.Swift.Version)
+}
+
+func TestTempURLAllows(t *testing.T) {
+	tempURL := info.TempURL{Methods: []string{"GET", "PUT"}}
+	th.CheckEquals(t, true, tempURL.AllowsMethod("PUT"))
+	th.CheckEquals(t, false, tempURL.AllowsMethod("DELETE"))
+
+	// Only SHA1 is assumed allowed when the digests aren't listed.
+	th.CheckEquals(t, true, tempURL.AllowsDigest("sha1"))
+	th.CheckEquals(t, false, tempURL.AllowsDigest("sha256"))
+
+	tempURL.AllowedDigests = []string{"sha256", "sha512"}
+	th.CheckEquals(t, false, tempURL.AllowsDigest("sha1"))
+	th.CheckEquals(t, true, tempURL.AllowsDigest("sha512"))
+}
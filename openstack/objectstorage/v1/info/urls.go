@@ -0,0 +1,26 @@
+package info
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// getURL returns the URL of the /info document, which is served at the root
+// of the Object Storage API, above its version and account.
+func getURL(c *gophercloud.ServiceClient) string {
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return c.Endpoint + "info"
+	}
+	if i := strings.Index(u.Path, "/v1/"); i >= 0 {
+		u.Path = u.Path[:i]
+	} else {
+		u.Path = strings.TrimSuffix(u.Path, "/")
+	}
+	u.Path += "/info"
+	u.RawPath = ""
+	u.RawQuery = ""
+	return u.String()
+}
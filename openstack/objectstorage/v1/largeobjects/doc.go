@@ -0,0 +1,72 @@
+/*
+Package largeobjects uploads, downloads and deletes Object Storage large
+objects, whose content exceeds the maximum size of a single object (5 GiB by
+default). A large object is made of segments, which are ordinary objects
+uploaded to a segment container, and of a manifest tying them together.
+
+A Static Large Object (SLO) manifest lists the segments, with their ETag and
+size, which Swift checks. A Dynamic Large Object (DLO) manifest names the
+container and the prefix of its segments, and serves whatever objects match
+them when downloaded.
+
+Example to Upload a Large Object
+
+	f, err := os.Open("disk.qcow2")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	createOpts := largeobjects.CreateOpts{
+		Content:     f,
+		SegmentSize: 512 * 1024 * 1024,
+		ContentType: "application/octet-stream",
+	}
+
+	manifest, err := largeobjects.Create(objectStorageClient, "images", "disk.qcow2", createOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Uploaded %d segments\n", len(manifest.Segments))
+
+Example to Resume an Interrupted Upload
+
+Segments already uploaded with the same content are skipped.
+
+	createOpts := largeobjects.CreateOpts{
+		Content:     f,
+		SegmentSize: 512 * 1024 * 1024,
+		Resume:      true,
+	}
+
+	manifest, err := largeobjects.Create(objectStorageClient, "images", "disk.qcow2", createOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%d segments were already uploaded\n", manifest.Resumed)
+
+Example to Download a Large Object
+
+The segments are downloaded in parallel, and written at their offset.
+
+	f, err := os.Create("disk.qcow2")
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	n, err := largeobjects.Download(objectStorageClient, "images", "disk.qcow2", f, largeobjects.DownloadOpts{})
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Large Object and its Segments
+
+	err := largeobjects.Delete(objectStorageClient, "images", "disk.qcow2")
+	if err != nil {
+		panic(err)
+	}
+*/
+package largeobjects
@@ -0,0 +1,44 @@
+package largeobjects
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ErrNotLargeObject is the error when an object expected to be a large object
+// has no manifest.
+type ErrNotLargeObject struct {
+	gophercloud.BaseError
+	Container string
+	Object    string
+}
+
+func (e ErrNotLargeObject) Error() string {
+	return fmt.Sprintf("Object [%s] in container [%s] is not a large object", e.Object, e.Container)
+}
+
+// ErrSegmentChecksum is the error when the content of a downloaded segment
+// doesn't match the ETag listed in the manifest.
+type ErrSegmentChecksum struct {
+	gophercloud.BaseError
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (e ErrSegmentChecksum) Error() string {
+	return fmt.Sprintf("Checksum of segment [%s] is %s, but the manifest lists %s", e.Path, e.Actual, e.Expected)
+}
+
+// ErrManifestDelete is the error when Swift failed to delete some of the
+// segments of a Static Large Object.
+type ErrManifestDelete struct {
+	gophercloud.BaseError
+	Status string
+	Errors [][]string
+}
+
+func (e ErrManifestDelete) Error() string {
+	return fmt.Sprintf("Unable to delete the static large object (%s): %v", e.Status, e.Errors)
+}
@@ -0,0 +1,495 @@
+package largeobjects
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+// ManifestType is the type of manifest tying the segments of a large object
+// together.
+type ManifestType string
+
+const (
+	// SLO is the type of Static Large Object manifests, listing each segment
+	// with its ETag and size.
+	SLO ManifestType = "slo"
+
+	// DLO is the type of Dynamic Large Object manifests, made of the objects of
+	// a container whose name starts with a prefix.
+	DLO ManifestType = "dlo"
+)
+
+const (
+	// DefaultSegmentSize is the size of the segments when CreateOpts doesn't set
+	// one.
+	DefaultSegmentSize = 100 * 1024 * 1024
+
+	// DefaultConcurrency is the number of segments transferred at once when the
+	// options don't set one.
+	DefaultConcurrency = 4
+)
+
+// CreateOpts is a structure that holds parameters for uploading a large
+// object.
+type CreateOpts struct {
+	// Content is the content of the large object, read until EOF.
+	Content io.Reader
+
+	// Type is the type of manifest to write. It defaults to SLO.
+	Type ManifestType
+
+	// SegmentSize is the size of each segment but the last, in bytes. It
+	// defaults to DefaultSegmentSize. Up to Concurrency segments are held in
+	// memory at once.
+	SegmentSize int64
+
+	// SegmentContainer is the container the segments are uploaded to, which is
+	// created if needed. It defaults to the container of the large object,
+	// suffixed with "_segments".
+	SegmentContainer string
+
+	// SegmentPrefix is prepended to the number of each segment to name it. It
+	// defaults to the name of the large object followed by a "/". Uploads
+	// sharing a prefix overwrite each other's segments.
+	SegmentPrefix string
+
+	// Concurrency is the number of segments uploaded at once. It defaults to
+	// DefaultConcurrency.
+	Concurrency int
+
+	// Resume skips the upload of the segments that already exist with the same
+	// content, uploaded by a previous attempt with the same SegmentSize.
+	Resume bool
+
+	// ContentType and Metadata are set on the large object.
+	ContentType string
+	Metadata    map[string]string
+}
+
+// Create uploads a large object, splitting Content into segments that are
+// uploaded in parallel, then writing its manifest. It returns the manifest
+// written.
+//
+// For a DLO, the other objects found with the prefix of the segments are
+// deleted, since they would otherwise be part of the large object.
+func Create(c *gophercloud.ServiceClient, containerName, objectName string, opts CreateOpts) (*Manifest, error) {
+	if opts.Content == nil {
+		return nil, gophercloud.ErrMissingInput{Argument: "Content"}
+	}
+	if opts.Type == "" {
+		opts.Type = SLO
+	}
+	if opts.Type != SLO && opts.Type != DLO {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "Type"
+		err.Value = opts.Type
+		return nil, err
+	}
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = DefaultSegmentSize
+	}
+	if opts.SegmentContainer == "" {
+		opts.SegmentContainer = containerName + "_segments"
+	}
+	if opts.SegmentPrefix == "" {
+		opts.SegmentPrefix = objectName + "/"
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultConcurrency
+	}
+
+	m := &Manifest{
+		Type:             opts.Type,
+		SegmentContainer: opts.SegmentContainer,
+		SegmentPrefix:    opts.SegmentPrefix,
+	}
+
+	if err := containers.Create(c, m.SegmentContainer, nil).Err; err != nil {
+		return nil, err
+	}
+
+	// A DLO is made of every object found with its prefix, so the existing
+	// ones are needed either way.
+	existing := make(map[string]objects.Object)
+	if opts.Resume || opts.Type == DLO {
+		objs, err := objects.ListAll(c, m.SegmentContainer, objects.ListOpts{Prefix: m.SegmentPrefix})
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range objs {
+			existing[o.Name] = o
+		}
+	}
+
+	segments, err := uploadSegments(c, opts, existing, m)
+	if err != nil {
+		return nil, err
+	}
+	m.Segments = segments
+
+	switch m.Type {
+	case SLO:
+		err = putStaticManifest(c, containerName, objectName, opts, m.Segments)
+	case DLO:
+		err = objects.Create(c, containerName, objectName, objects.CreateOpts{
+			Content:        strings.NewReader(""),
+			ContentType:    opts.ContentType,
+			Metadata:       opts.Metadata,
+			ObjectManifest: m.SegmentContainer + "/" + m.SegmentPrefix,
+		}).Err
+		if err != nil {
+			return nil, err
+		}
+
+		uploaded := make(map[string]bool, len(m.Segments))
+		for _, s := range m.Segments {
+			uploaded[s.Object()] = true
+		}
+		var stale []Segment
+		for name := range existing {
+			if !uploaded[name] {
+				stale = append(stale, Segment{Path: "/" + m.SegmentContainer + "/" + name})
+			}
+		}
+		err = deleteSegments(c, stale, opts.Concurrency)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// uploadSegments reads the segments of opts.Content and uploads those that
+// don't already exist with the same content when resuming. At most
+// opts.Concurrency segments are read ahead and uploaded at once.
+func uploadSegments(c *gophercloud.ServiceClient, opts CreateOpts, existing map[string]objects.Object, m *Manifest) ([]Segment, error) {
+	ctx, cancel := context.WithCancel(c.ContextOrBackground())
+	defer cancel()
+	sc := c.WithContext(ctx)
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		errOnce.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	var segments []Segment
+	slots := make(chan struct{}, opts.Concurrency)
+	for i := 0; ; i++ {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		buf := make([]byte, opts.SegmentSize)
+		n, err := io.ReadFull(opts.Content, buf)
+		if err == io.EOF {
+			<-slots
+			break
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			<-slots
+			fail(err)
+			break
+		}
+		buf = buf[:n]
+
+		name := fmt.Sprintf("%s%08d", m.SegmentPrefix, i)
+		s := Segment{
+			Path:      "/" + m.SegmentContainer + "/" + name,
+			ETag:      fmt.Sprintf("%x", md5.Sum(buf)),
+			SizeBytes: int64(n),
+		}
+		segments = append(segments, s)
+
+		if o, ok := existing[name]; ok && opts.Resume && o.Hash == s.ETag && o.Bytes == s.SizeBytes {
+			m.Resumed++
+			<-slots
+		} else {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-slots }()
+				err := objects.Create(sc, m.SegmentContainer, name, objects.CreateOpts{
+					Content: bytes.NewReader(buf),
+				}).Err
+				if err != nil {
+					fail(err)
+				}
+			}()
+		}
+
+		if err == io.ErrUnexpectedEOF {
+			break
+		}
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	// The caller's context may have been cancelled.
+	if err := c.ContextOrBackground().Err(); err != nil {
+		return nil, err
+	}
+
+	return segments, nil
+}
+
+// putStaticManifest writes the manifest of a Static Large Object. Its ETag is
+// the checksum of the ETags of the segments, which Swift checks.
+func putStaticManifest(c *gophercloud.ServiceClient, containerName, objectName string, opts CreateOpts, segments []Segment) error {
+	b, err := json.Marshal(segments)
+	if err != nil {
+		return err
+	}
+
+	hash := md5.New()
+	for _, s := range segments {
+		io.WriteString(hash, s.ETag)
+	}
+
+	h := map[string]string{
+		"Content-Type": opts.ContentType,
+		"ETag":         fmt.Sprintf("%x", hash.Sum(nil)),
+	}
+	for k, v := range opts.Metadata {
+		h["X-Object-Meta-"+k] = v
+	}
+
+	url := c.ServiceURL(containerName, objectName) + "?multipart-manifest=put"
+	_, err = c.Request("PUT", url, &gophercloud.RequestOpts{
+		RawBody:     bytes.NewReader(b),
+		MoreHeaders: h,
+		OkCodes:     []int{201},
+	})
+	return err
+}
+
+// GetManifest returns the manifest of a large object. It returns an
+// ErrNotLargeObject error when the object has none.
+func GetManifest(c *gophercloud.ServiceClient, containerName, objectName string) (*Manifest, error) {
+	h, err := objects.Get(c, containerName, objectName, nil).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case h.StaticLargeObject:
+		r := objects.Download(c, containerName, objectName, objects.DownloadOpts{MultipartManifest: "get"})
+		b, err := r.ExtractContent()
+		if err != nil {
+			return nil, err
+		}
+
+		var listed []manifestSegment
+		if err := json.Unmarshal(b, &listed); err != nil {
+			return nil, err
+		}
+
+		m := &Manifest{Type: SLO, Segments: make([]Segment, len(listed))}
+		for i, s := range listed {
+			m.Segments[i] = Segment{Path: s.Name, ETag: s.Hash, SizeBytes: s.Bytes}
+		}
+		return m, nil
+
+	case h.ObjectManifest != "":
+		container, prefix := splitPath(h.ObjectManifest)
+		objs, err := objects.ListAll(c, container, objects.ListOpts{Prefix: prefix})
+		if err != nil {
+			return nil, err
+		}
+
+		m := &Manifest{
+			Type:             DLO,
+			SegmentContainer: container,
+			SegmentPrefix:    prefix,
+			Segments:         make([]Segment, len(objs)),
+		}
+		for i, o := range objs {
+			m.Segments[i] = Segment{Path: "/" + container + "/" + o.Name, ETag: o.Hash, SizeBytes: o.Bytes}
+		}
+		return m, nil
+	}
+
+	return nil, ErrNotLargeObject{Container: containerName, Object: objectName}
+}
+
+// DownloadOpts is a structure that holds parameters for downloading a large
+// object.
+type DownloadOpts struct {
+	// Concurrency is the number of segments downloaded at once. It defaults to
+	// DefaultConcurrency.
+	Concurrency int
+}
+
+// Download downloads an object to w, and returns the number of bytes written.
+// The segments of a large object are downloaded in parallel, written at their
+// offset, and checked against the ETags of its manifest. Other objects are
+// downloaded in a single request.
+func Download(c *gophercloud.ServiceClient, containerName, objectName string, w io.WriterAt, opts DownloadOpts) (int64, error) {
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultConcurrency
+	}
+
+	m, err := GetManifest(c, containerName, objectName)
+	if _, ok := err.(ErrNotLargeObject); ok {
+		r := objects.Download(c, containerName, objectName, nil)
+		if r.Err != nil {
+			return 0, r.Err
+		}
+		defer r.Body.Close()
+		return io.Copy(io.NewOffsetWriter(w, 0), r.Body)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithCancel(c.ContextOrBackground())
+	defer cancel()
+	sc := c.WithContext(ctx)
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	slots := make(chan struct{}, opts.Concurrency)
+
+	var offset int64
+	for _, s := range m.Segments {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(s Segment, offset int64) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := downloadSegment(sc, s, io.NewOffsetWriter(w, offset)); err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(s, offset)
+		offset += s.SizeBytes
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return 0, firstErr
+	}
+	if err := c.ContextOrBackground().Err(); err != nil {
+		return 0, err
+	}
+
+	return m.Size(), nil
+}
+
+// downloadSegment downloads a segment to w, checking its ETag.
+func downloadSegment(c *gophercloud.ServiceClient, s Segment, w io.Writer) error {
+	r := objects.Download(c, s.Container(), s.Object(), nil)
+	if r.Err != nil {
+		return r.Err
+	}
+	defer r.Body.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(io.MultiWriter(w, hash), r.Body); err != nil {
+		return err
+	}
+
+	// The ETag of a segment that is itself a Static Large Object is not the
+	// checksum of its content.
+	if r.Header.Get("X-Static-Large-Object") != "" || s.ETag == "" {
+		return nil
+	}
+	if sum := fmt.Sprintf("%x", hash.Sum(nil)); sum != s.ETag {
+		return ErrSegmentChecksum{Path: s.Path, Expected: s.ETag, Actual: sum}
+	}
+	return nil
+}
+
+// Delete deletes a large object along with its segments.
+func Delete(c *gophercloud.ServiceClient, containerName, objectName string) error {
+	m, err := GetManifest(c, containerName, objectName)
+	if err != nil {
+		return err
+	}
+
+	if m.Type == SLO {
+		// Swift deletes the segments of a Static Large Object itself.
+		url := c.ServiceURL(containerName, objectName) + "?multipart-manifest=delete"
+		var res deleteResponse
+		_, err := c.Request("DELETE", url, &gophercloud.RequestOpts{
+			JSONResponse: &res,
+			OkCodes:      []int{200},
+		})
+		if err != nil {
+			return err
+		}
+		if len(res.Errors) > 0 || !strings.HasPrefix(res.ResponseStatus, "2") {
+			return ErrManifestDelete{Status: res.ResponseStatus, Errors: res.Errors}
+		}
+		return nil
+	}
+
+	if err := deleteSegments(c, m.Segments, DefaultConcurrency); err != nil {
+		return err
+	}
+	return objects.Delete(c, containerName, objectName, nil).Err
+}
+
+// deleteSegments deletes segments, concurrency at a time, ignoring those
+// already gone.
+func deleteSegments(c *gophercloud.ServiceClient, segments []Segment, concurrency int) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	slots := make(chan struct{}, concurrency)
+	for _, s := range segments {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(s Segment) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			err := objects.Delete(c, s.Container(), s.Object(), nil).Err
+			if _, ok := err.(gophercloud.ErrDefault404); err != nil && !ok {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(s)
+	}
+	wg.Wait()
+	return firstErr
+}
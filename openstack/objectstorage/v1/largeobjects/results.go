@@ -0,0 +1,84 @@
+package largeobjects
+
+import (
+	"strings"
+)
+
+// Manifest describes a large object and its segments.
+type Manifest struct {
+	// Type is the type of the manifest.
+	Type ManifestType
+
+	// SegmentContainer and SegmentPrefix are the container and the name prefix
+	// of the segments. They're left empty for a Static Large Object read back
+	// with GetManifest, whose segments may be in several containers.
+	SegmentContainer string
+	SegmentPrefix    string
+
+	// Segments are the segments of the large object, in order.
+	Segments []Segment
+
+	// Resumed is the number of segments Create found already uploaded, and
+	// didn't upload again.
+	Resumed int
+}
+
+// Size returns the size of the large object, in bytes.
+func (m Manifest) Size() int64 {
+	var size int64
+	for _, s := range m.Segments {
+		size += s.SizeBytes
+	}
+	return size
+}
+
+// Segment is a segment of a large object, as listed in a Static Large Object
+// manifest.
+type Segment struct {
+	// Path is the path of the segment, in the "/container/object" form.
+	Path string `json:"path"`
+
+	// ETag is the MD5 checksum of the content of the segment.
+	ETag string `json:"etag"`
+
+	// SizeBytes is the size of the segment, in bytes.
+	SizeBytes int64 `json:"size_bytes"`
+}
+
+// Container returns the name of the container holding the segment.
+func (s Segment) Container() string {
+	container, _ := splitPath(s.Path)
+	return container
+}
+
+// Object returns the name of the segment within its container.
+func (s Segment) Object() string {
+	_, object := splitPath(s.Path)
+	return object
+}
+
+// splitPath splits a "/container/object" path.
+func splitPath(path string) (string, string) {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// manifestSegment is a segment as listed by Swift when getting a Static Large
+// Object manifest.
+type manifestSegment struct {
+	Name  string `json:"name"`
+	Hash  string `json:"hash"`
+	Bytes int64  `json:"bytes"`
+}
+
+// deleteResponse is the report Swift returns when deleting a Static Large
+// Object along with its segments.
+type deleteResponse struct {
+	NumberDeleted  int        `json:"Number Deleted"`
+	NumberNotFound int        `json:"Number Not Found"`
+	ResponseStatus string     `json:"Response Status"`
+	Errors         [][]string `json:"Errors"`
+}
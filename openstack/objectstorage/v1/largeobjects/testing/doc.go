@@ -0,0 +1,2 @@
+// objectstorage_largeobjects_v1
+package testing
@@ -0,0 +1,247 @@
+package testing
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/largeobjects"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// Content is the content of the large object, split into segments of
+// SegmentSize bytes.
+const Content = "abcdefghij"
+
+// SegmentSize is the size of the segments of Content.
+const SegmentSize = 4
+
+// SegmentContents are the contents of the segments of Content.
+var SegmentContents = []string{"abcd", "efgh", "ij"}
+
+func etag(s string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(s)))
+}
+
+// ExpectedSegments are the segments of Content.
+var ExpectedSegments = []largeobjects.Segment{
+	{Path: "/testContainer_segments/testObject/00000000", ETag: etag("abcd"), SizeBytes: 4},
+	{Path: "/testContainer_segments/testObject/00000001", ETag: etag("efgh"), SizeBytes: 4},
+	{Path: "/testContainer_segments/testObject/00000002", ETag: etag("ij"), SizeBytes: 2},
+}
+
+// ManifestETag is the ETag of the Static Large Object manifest of Content.
+var ManifestETag = etag(etag("abcd") + etag("efgh") + etag("ij"))
+
+// CreateSLOManifestRequest is the manifest expected to be written for
+// Content.
+var CreateSLOManifestRequest = fmt.Sprintf(`[
+  {"path": "/testContainer_segments/testObject/00000000", "etag": "%s", "size_bytes": 4},
+  {"path": "/testContainer_segments/testObject/00000001", "etag": "%s", "size_bytes": 4},
+  {"path": "/testContainer_segments/testObject/00000002", "etag": "%s", "size_bytes": 2}
+]`, etag("abcd"), etag("efgh"), etag("ij"))
+
+// GetSLOManifestResponse is the manifest of Content, as returned by Swift.
+var GetSLOManifestResponse = fmt.Sprintf(`[
+  {"name": "/testContainer_segments/testObject/00000000", "hash": "%s", "bytes": 4, "content_type": "application/octet-stream", "last_modified": "2016-08-17T22:11:58.602650"},
+  {"name": "/testContainer_segments/testObject/00000001", "hash": "%s", "bytes": 4, "content_type": "application/octet-stream", "last_modified": "2016-08-17T22:11:58.602650"},
+  {"name": "/testContainer_segments/testObject/00000002", "hash": "%s", "bytes": 2, "content_type": "application/octet-stream", "last_modified": "2016-08-17T22:11:58.602650"}
+]`, etag("abcd"), etag("efgh"), etag("ij"))
+
+// DeleteSLOResponse is the report of the deletion of a Static Large Object.
+const DeleteSLOResponse = `
+{
+  "Number Not Found": 0,
+  "Response Status": "200 OK",
+  "Errors": [],
+  "Number Deleted": 4,
+  "Response Body": ""
+}
+`
+
+// DeleteSLOFailedResponse is the report of a failed deletion of a Static Large
+// Object.
+const DeleteSLOFailedResponse = `
+{
+  "Number Not Found": 0,
+  "Response Status": "400 Bad Request",
+  "Errors": [["/testContainer_segments/testObject/00000001", "409 Conflict"]],
+  "Number Deleted": 3,
+  "Response Body": ""
+}
+`
+
+// SegmentStore records the segments uploaded and deleted.
+type SegmentStore struct {
+	mu       sync.Mutex
+	Uploaded map[string]string
+	Deleted  []string
+}
+
+// HandleCreateSegmentContainerSuccessfully creates an HTTP handler at
+// `/testContainer_segments` on the test handler mux that responds with a
+// container Create response, and with the listing of the given segments.
+func HandleCreateSegmentContainerSuccessfully(t *testing.T, listing string) {
+	th.Mux.HandleFunc("/testContainer_segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			w.WriteHeader(http.StatusCreated)
+		case "GET":
+			r.ParseForm()
+			th.CheckEquals(t, "testObject/", r.Form.Get("prefix"))
+			w.Header().Set("Content-Type", "application/json")
+			if r.Form.Get("marker") == "" {
+				fmt.Fprint(w, listing)
+			} else {
+				fmt.Fprint(w, `[]`)
+			}
+		default:
+			t.Fatalf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleSegmentsSuccessfully creates HTTP handlers at
+// `/testContainer_segments/testObject/0000000N` on the test handler mux that
+// store the segments uploaded, and serve SegmentContents. The handlers also
+// handle the deletion of the given stale segments.
+func HandleSegmentsSuccessfully(t *testing.T, stale ...string) *SegmentStore {
+	store := &SegmentStore{Uploaded: make(map[string]string)}
+
+	handle := func(path, content string) {
+		th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+			switch r.Method {
+			case "PUT":
+				b, err := ioutil.ReadAll(r.Body)
+				th.AssertNoErr(t, err)
+				th.TestHeader(t, r, "ETag", etag(string(b)))
+				store.mu.Lock()
+				store.Uploaded[path] = string(b)
+				store.mu.Unlock()
+				w.Header().Set("ETag", etag(string(b)))
+				w.WriteHeader(http.StatusCreated)
+			case "HEAD":
+				w.Header().Set("ETag", etag(content))
+				w.WriteHeader(http.StatusOK)
+			case "GET":
+				w.Header().Set("ETag", etag(content))
+				fmt.Fprint(w, content)
+			case "DELETE":
+				store.mu.Lock()
+				store.Deleted = append(store.Deleted, path)
+				store.mu.Unlock()
+				w.WriteHeader(http.StatusNoContent)
+			default:
+				t.Fatalf("Unexpected method %s", r.Method)
+			}
+		})
+	}
+
+	for i, s := range ExpectedSegments {
+		handle(s.Path, SegmentContents[i])
+	}
+	for _, path := range stale {
+		handle(path, "")
+	}
+
+	return store
+}
+
+// HandleCreateSLOManifestSuccessfully creates an HTTP handler at
+// `/testContainer/testObject` on the test handler mux that responds with a
+// Create response, when given the manifest of Content.
+func HandleCreateSLOManifestSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/testContainer/testObject", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/octet-stream")
+		th.TestHeader(t, r, "ETag", ManifestETag)
+		th.TestHeader(t, r, "X-Object-Meta-Build", "42")
+		th.TestFormValues(t, r, map[string]string{"multipart-manifest": "put"})
+		th.TestJSONRequest(t, r, CreateSLOManifestRequest)
+
+		w.Header().Set("ETag", `"`+ManifestETag+`"`)
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+// HandleCreateDLOManifestSuccessfully creates an HTTP handler at
+// `/testContainer/testObject` on the test handler mux that responds with a
+// Create response, when given a Dynamic Large Object manifest.
+func HandleCreateDLOManifestSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/testContainer/testObject", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "X-Object-Manifest", "testContainer_segments/testObject/")
+
+		b, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		th.CheckEquals(t, 0, len(b))
+
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+// HandleSLOManifestSuccessfully creates an HTTP handler at
+// `/testContainer/testObject` on the test handler mux that serves a Static
+// Large Object of Content, and handles its deletion with the given report.
+func HandleSLOManifestSuccessfully(t *testing.T, deleteResponse string) {
+	th.Mux.HandleFunc("/testContainer/testObject", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "HEAD":
+			w.Header().Set("X-Static-Large-Object", "True")
+			w.WriteHeader(http.StatusOK)
+		case "GET":
+			th.TestFormValues(t, r, map[string]string{"multipart-manifest": "get"})
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, GetSLOManifestResponse)
+		case "DELETE":
+			th.TestFormValues(t, r, map[string]string{"multipart-manifest": "delete"})
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, deleteResponse)
+		default:
+			t.Fatalf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleDLOManifestSuccessfully creates an HTTP handler at
+// `/testContainer/testObject` on the test handler mux that serves a Dynamic
+// Large Object of Content, and handles its deletion.
+func HandleDLOManifestSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/testContainer/testObject", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "HEAD":
+			w.Header().Set("X-Object-Manifest", "testContainer_segments/testObject/")
+			w.WriteHeader(http.StatusOK)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// SegmentsListing lists the given segments, in the format of a full object
+// listing of the segment container.
+func SegmentsListing(segments []largeobjects.Segment) string {
+	listing := make([]string, len(segments))
+	for i, s := range segments {
+		listing[i] = fmt.Sprintf(`{"name": "%s", "hash": "%s", "bytes": %d, "content_type": "application/octet-stream", "last_modified": "2016-08-17T22:11:58.602650"}`,
+			s.Object(), s.ETag, s.SizeBytes)
+	}
+	return "[" + strings.Join(listing, ",") + "]"
+}
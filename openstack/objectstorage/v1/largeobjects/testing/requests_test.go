@@ -0,0 +1,261 @@
+package testing
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/largeobjects"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+// buffer is an io.WriterAt collecting what's written to it.
+type buffer struct {
+	mu sync.Mutex
+	b  []byte
+}
+
+func (b *buffer) WriteAt(p []byte, off int64) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if end := int(off) + len(p); end > len(b.b) {
+		b.b = append(b.b, make([]byte, end-len(b.b))...)
+	}
+	copy(b.b[off:], p)
+	return len(p), nil
+}
+
+func TestCreateSLO(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSegmentContainerSuccessfully(t, "[]")
+	store := HandleSegmentsSuccessfully(t)
+	HandleCreateSLOManifestSuccessfully(t)
+
+	opts := largeobjects.CreateOpts{
+		Content:     strings.NewReader(Content),
+		SegmentSize: SegmentSize,
+		ContentType: "application/octet-stream",
+		Metadata:    map[string]string{"Build": "42"},
+	}
+	actual, err := largeobjects.Create(fake.ServiceClient(), "testContainer", "testObject", opts)
+	th.AssertNoErr(t, err)
+
+	expected := &largeobjects.Manifest{
+		Type:             largeobjects.SLO,
+		SegmentContainer: "testContainer_segments",
+		SegmentPrefix:    "testObject/",
+		Segments:         ExpectedSegments,
+	}
+	th.CheckDeepEquals(t, expected, actual)
+	th.CheckEquals(t, int64(len(Content)), actual.Size())
+
+	th.CheckEquals(t, 3, len(store.Uploaded))
+	for i, s := range ExpectedSegments {
+		th.CheckEquals(t, SegmentContents[i], store.Uploaded[s.Path])
+	}
+}
+
+func TestCreateSLOResume(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSegmentContainerSuccessfully(t, SegmentsListing(ExpectedSegments[:2]))
+	store := HandleSegmentsSuccessfully(t)
+	HandleCreateSLOManifestSuccessfully(t)
+
+	opts := largeobjects.CreateOpts{
+		Content:     strings.NewReader(Content),
+		SegmentSize: SegmentSize,
+		ContentType: "application/octet-stream",
+		Metadata:    map[string]string{"Build": "42"},
+		Resume:      true,
+	}
+	actual, err := largeobjects.Create(fake.ServiceClient(), "testContainer", "testObject", opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 2, actual.Resumed)
+	th.CheckDeepEquals(t, ExpectedSegments, actual.Segments)
+
+	// Only the last segment is uploaded.
+	th.CheckDeepEquals(t, map[string]string{ExpectedSegments[2].Path: "ij"}, store.Uploaded)
+}
+
+func TestCreateDLO(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	stale := largeobjects.Segment{Path: "/testContainer_segments/testObject/00000005", ETag: "d41d8cd98f00b204e9800998ecf8427e", SizeBytes: 1}
+	HandleCreateSegmentContainerSuccessfully(t, SegmentsListing([]largeobjects.Segment{ExpectedSegments[0], stale}))
+	store := HandleSegmentsSuccessfully(t, stale.Path)
+	HandleCreateDLOManifestSuccessfully(t)
+
+	opts := largeobjects.CreateOpts{
+		Content:     strings.NewReader(Content),
+		SegmentSize: SegmentSize,
+		Type:        largeobjects.DLO,
+	}
+	actual, err := largeobjects.Create(fake.ServiceClient(), "testContainer", "testObject", opts)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, largeobjects.DLO, actual.Type)
+	th.CheckDeepEquals(t, ExpectedSegments, actual.Segments)
+
+	// Without resuming, every segment is uploaded again.
+	th.CheckEquals(t, 3, len(store.Uploaded))
+	// The stale segment is deleted, since it would be part of the object.
+	th.CheckDeepEquals(t, []string{stale.Path}, store.Deleted)
+}
+
+func TestCreateUploadFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSegmentContainerSuccessfully(t, "[]")
+
+	opts := largeobjects.CreateOpts{
+		Content:     strings.NewReader(Content),
+		SegmentSize: SegmentSize,
+	}
+	// No segment handler is registered, so that the uploads fail.
+	_, err := largeobjects.Create(fake.ServiceClient(), "testContainer", "testObject", opts)
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("Expected a 404 error, but got %#v", err)
+	}
+}
+
+func TestCreateInvalidOpts(t *testing.T) {
+	_, err := largeobjects.Create(fake.ServiceClient(), "testContainer", "testObject", largeobjects.CreateOpts{})
+	if err == nil {
+		t.Fatalf("Expected an error for missing content")
+	}
+
+	opts := largeobjects.CreateOpts{
+		Content: strings.NewReader(Content),
+		Type:    "xlo",
+	}
+	_, err = largeobjects.Create(fake.ServiceClient(), "testContainer", "testObject", opts)
+	if err == nil {
+		t.Fatalf("Expected an error for an invalid type")
+	}
+}
+
+func TestGetSLOManifest(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSLOManifestSuccessfully(t, DeleteSLOResponse)
+
+	actual, err := largeobjects.GetManifest(fake.ServiceClient(), "testContainer", "testObject")
+	th.AssertNoErr(t, err)
+
+	expected := &largeobjects.Manifest{
+		Type:     largeobjects.SLO,
+		Segments: ExpectedSegments,
+	}
+	th.CheckDeepEquals(t, expected, actual)
+	th.CheckEquals(t, "testContainer_segments", actual.Segments[0].Container())
+	th.CheckEquals(t, "testObject/00000000", actual.Segments[0].Object())
+}
+
+func TestGetDLOManifest(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDLOManifestSuccessfully(t)
+	HandleCreateSegmentContainerSuccessfully(t, SegmentsListing(ExpectedSegments))
+
+	actual, err := largeobjects.GetManifest(fake.ServiceClient(), "testContainer", "testObject")
+	th.AssertNoErr(t, err)
+
+	expected := &largeobjects.Manifest{
+		Type:             largeobjects.DLO,
+		SegmentContainer: "testContainer_segments",
+		SegmentPrefix:    "testObject/",
+		Segments:         ExpectedSegments,
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestGetManifestNotLargeObject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSegmentsSuccessfully(t)
+
+	_, err := largeobjects.GetManifest(fake.ServiceClient(), "testContainer_segments", "testObject/00000000")
+	if _, ok := err.(largeobjects.ErrNotLargeObject); !ok {
+		t.Fatalf("Expected an ErrNotLargeObject, but got %#v", err)
+	}
+}
+
+func TestDownloadSLO(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSLOManifestSuccessfully(t, DeleteSLOResponse)
+	HandleSegmentsSuccessfully(t)
+
+	w := &buffer{}
+	n, err := largeobjects.Download(fake.ServiceClient(), "testContainer", "testObject", w, largeobjects.DownloadOpts{Concurrency: 2})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, int64(len(Content)), n)
+	th.CheckEquals(t, Content, string(w.b))
+}
+
+func TestDownloadSLOChecksumMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSLOManifestSuccessfully(t, DeleteSLOResponse)
+
+	// Serve a second segment that doesn't match the manifest.
+	SegmentContents[1] = "efgX"
+	defer func() { SegmentContents[1] = "efgh" }()
+	HandleSegmentsSuccessfully(t)
+
+	_, err := largeobjects.Download(fake.ServiceClient(), "testContainer", "testObject", &buffer{}, largeobjects.DownloadOpts{})
+	if e, ok := err.(largeobjects.ErrSegmentChecksum); !ok || e.Path != ExpectedSegments[1].Path {
+		t.Fatalf("Expected an ErrSegmentChecksum for the second segment, but got %#v", err)
+	}
+}
+
+func TestDownloadObject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSegmentsSuccessfully(t)
+
+	w := &buffer{}
+	n, err := largeobjects.Download(fake.ServiceClient(), "testContainer_segments", "testObject/00000001", w, largeobjects.DownloadOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, int64(4), n)
+	th.CheckEquals(t, "efgh", string(w.b))
+}
+
+func TestDeleteSLO(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSLOManifestSuccessfully(t, DeleteSLOResponse)
+
+	err := largeobjects.Delete(fake.ServiceClient(), "testContainer", "testObject")
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteSLOFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSLOManifestSuccessfully(t, DeleteSLOFailedResponse)
+
+	err := largeobjects.Delete(fake.ServiceClient(), "testContainer", "testObject")
+	e, ok := err.(largeobjects.ErrManifestDelete)
+	if !ok {
+		t.Fatalf("Expected an ErrManifestDelete, but got %#v", err)
+	}
+	th.CheckEquals(t, "400 Bad Request", e.Status)
+	th.CheckDeepEquals(t, [][]string{{"/testContainer_segments/testObject/00000001", "409 Conflict"}}, e.Errors)
+}
+
+func TestDeleteDLO(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDLOManifestSuccessfully(t)
+	HandleCreateSegmentContainerSuccessfully(t, SegmentsListing(ExpectedSegments))
+	store := HandleSegmentsSuccessfully(t)
+
+	err := largeobjects.Delete(fake.ServiceClient(), "testContainer", "testObject")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 3, len(store.Deleted))
+}
@@ -1,6 +1,10 @@
 package objects
 
-import "github.com/gophercloud/gophercloud"
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
 
 // ErrWrongChecksum is the error when the checksum generated for an object
 // doesn't match the ETAG header.
@@ -11,3 +15,47 @@ type ErrWrongChecksum struct {
 func (e ErrWrongChecksum) Error() string {
 	return "Local checksum does not match API ETag header"
 }
+
+// ErrTempURLNotAllowed is the error when the cluster doesn't allow temporary
+// URLs with the requested option.
+type ErrTempURLNotAllowed struct {
+	gophercloud.BaseError
+	Option string
+	Value  string
+}
+
+func (e ErrTempURLNotAllowed) Error() string {
+	return fmt.Sprintf("The cluster doesn't allow temporary URLs with the %s %s", e.Value, e.Option)
+}
+
+// ErrTempURLNotSupported is the error when the cluster doesn't run the
+// temporary URL middleware.
+type ErrTempURLNotSupported struct {
+	gophercloud.BaseError
+}
+
+func (e ErrTempURLNotSupported) Error() string {
+	return "The cluster doesn't support temporary URLs"
+}
+
+// ErrNotSymlink is the error when an object resolved as a symlink isn't one.
+type ErrNotSymlink struct {
+	gophercloud.BaseError
+	Container string
+	Object    string
+}
+
+func (e ErrNotSymlink) Error() string {
+	return fmt.Sprintf("Object [%s] of container [%s] is not a symlink", e.Object, e.Container)
+}
+
+// ErrInvalidSymlinkTarget is the error when the target of a symlink isn't in
+// the "container/object" form.
+type ErrInvalidSymlinkTarget struct {
+	gophercloud.BaseError
+	Target string
+}
+
+func (e ErrInvalidSymlinkTarget) Error() string {
+	return fmt.Sprintf("Invalid symlink target: [%s]", e.Target)
+}
@@ -5,13 +5,19 @@ import (
 	"crypto/hmac"
 	"crypto/md5"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
 	"fmt"
+	"hash"
 	"io"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/info"
 	"github.com/gophercloud/gophercloud/pagination"
 )
 
@@ -35,6 +41,12 @@ type ListOpts struct {
 	Prefix    string `q:"prefix"`
 	Delimiter string `q:"delimiter"`
 	Path      string `q:"path"`
+
+	// Versions lists every version of the objects, with the object_versioning
+	// middleware. The versions of an object are listed from the latest, and
+	// VersionMarker resumes the listing after the version of Marker it names.
+	Versions      bool   `q:"versions"`
+	VersionMarker string `q:"version_marker"`
 }
 
 // ToObjectListParams formats a ListOpts into a query string and boolean
@@ -72,6 +84,48 @@ func List(c *gophercloud.ServiceClient, containerName string, opts ListOptsBuild
 	return pager
 }
 
+// ListAll returns the objects of a container matching opts from every page of
+// a List request, with their full information. It always requests a full
+// listing, regardless of opts.Full.
+func ListAll(c *gophercloud.ServiceClient, containerName string, opts ListOpts) ([]Object, error) {
+	opts.Full = true
+	return pagination.All(List(c, containerName, opts), ExtractInfo)
+}
+
+// ListVersions returns every version of an object, from the latest, including
+// the delete markers left by deleting it. It requires the object_versioning
+// middleware to be enabled on the container.
+func ListVersions(c *gophercloud.ServiceClient, containerName, objectName string) ([]Object, error) {
+	opts := ListOpts{Full: true, Prefix: objectName, Versions: true}
+
+	var versions []Object
+	for {
+		_, query, err := opts.ToObjectListParams()
+		if err != nil {
+			return nil, err
+		}
+
+		var page []Object
+		_, err = c.Get(listURL(c, containerName)+query, &page, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range page {
+			if o.Name == objectName {
+				versions = append(versions, o)
+			}
+		}
+
+		// The objects are listed by name, so that the listing is over as soon as
+		// another object than objectName is listed.
+		if len(page) == 0 || page[len(page)-1].Name != objectName {
+			return versions, nil
+		}
+		opts.Marker = objectName
+		opts.VersionMarker = page[len(page)-1].VersionID
+	}
+}
+
 // DownloadOptsBuilder allows extensions to add additional parameters to the
 // Download request.
 type DownloadOptsBuilder interface {
@@ -79,6 +133,10 @@ type DownloadOptsBuilder interface {
 }
 
 // DownloadOpts is a structure that holds parameters for downloading an object.
+//
+// VersionID downloads a version of the object other than the latest one, and
+// Symlink set to "get" downloads a symlink itself rather than the object it
+// links to.
 type DownloadOpts struct {
 	IfMatch           string    `h:"If-Match"`
 	IfModifiedSince   time.Time `h:"If-Modified-Since"`
@@ -88,6 +146,8 @@ type DownloadOpts struct {
 	Expires           string    `q:"expires"`
 	MultipartManifest string    `q:"multipart-manifest"`
 	Signature         string    `q:"signature"`
+	Symlink           string    `q:"symlink"`
+	VersionID         string    `q:"version-id"`
 }
 
 // ToObjectDownloadParams formats a DownloadOpts into a query string and map of
@@ -141,25 +201,32 @@ type CreateOptsBuilder interface {
 }
 
 // CreateOpts is a structure that holds parameters for creating an object.
+//
+// The object expires, and is deleted by the cluster, at DeleteAt, a Unix
+// time, or DeleteAfter seconds after it's created. Setting SymlinkTarget, to
+// "container/object", creates a symlink without Content, as CreateSymlink does.
 type CreateOpts struct {
-	Content            io.Reader
-	Metadata           map[string]string
-	CacheControl       string `h:"Cache-Control"`
-	ContentDisposition string `h:"Content-Disposition"`
-	ContentEncoding    string `h:"Content-Encoding"`
-	ContentLength      int64  `h:"Content-Length"`
-	ContentType        string `h:"Content-Type"`
-	CopyFrom           string `h:"X-Copy-From"`
-	DeleteAfter        int    `h:"X-Delete-After"`
-	DeleteAt           int    `h:"X-Delete-At"`
-	DetectContentType  string `h:"X-Detect-Content-Type"`
-	ETag               string `h:"ETag"`
-	IfNoneMatch        string `h:"If-None-Match"`
-	ObjectManifest     string `h:"X-Object-Manifest"`
-	TransferEncoding   string `h:"Transfer-Encoding"`
-	Expires            string `q:"expires"`
-	MultipartManifest  string `q:"multipart-manifest"`
-	Signature          string `q:"signature"`
+	Content              io.Reader
+	Metadata             map[string]string
+	CacheControl         string `h:"Cache-Control"`
+	ContentDisposition   string `h:"Content-Disposition"`
+	ContentEncoding      string `h:"Content-Encoding"`
+	ContentLength        int64  `h:"Content-Length"`
+	ContentType          string `h:"Content-Type"`
+	CopyFrom             string `h:"X-Copy-From"`
+	DeleteAfter          int    `h:"X-Delete-After"`
+	DeleteAt             int    `h:"X-Delete-At"`
+	DetectContentType    string `h:"X-Detect-Content-Type"`
+	ETag                 string `h:"ETag"`
+	IfNoneMatch          string `h:"If-None-Match"`
+	ObjectManifest       string `h:"X-Object-Manifest"`
+	SymlinkTarget        string `h:"X-Symlink-Target"`
+	SymlinkTargetAccount string `h:"X-Symlink-Target-Account"`
+	SymlinkTargetETag    string `h:"X-Symlink-Target-Etag"`
+	TransferEncoding     string `h:"Transfer-Encoding"`
+	Expires              string `q:"expires"`
+	MultipartManifest    string `q:"multipart-manifest"`
+	Signature            string `q:"signature"`
 }
 
 // ToObjectCreateParams formats a CreateOpts into a query string and map of
@@ -180,9 +247,11 @@ func (opts CreateOpts) ToObjectCreateParams() (io.Reader, map[string]string, str
 
 	hash := md5.New()
 	buf := bytes.NewBuffer([]byte{})
-	_, err = io.Copy(io.MultiWriter(hash, buf), opts.Content)
-	if err != nil {
-		return nil, nil, "", err
+	if opts.Content != nil {
+		_, err = io.Copy(io.MultiWriter(hash, buf), opts.Content)
+		if err != nil {
+			return nil, nil, "", err
+		}
 	}
 	localChecksum := fmt.Sprintf("%x", hash.Sum(nil))
 	h["ETag"] = localChecksum
@@ -280,8 +349,11 @@ type DeleteOptsBuilder interface {
 }
 
 // DeleteOpts is a structure that holds parameters for deleting an object.
+// VersionID deletes a version of the object, instead of adding a delete marker
+// to its versions.
 type DeleteOpts struct {
 	MultipartManifest string `q:"multipart-manifest"`
+	VersionID         string `q:"version-id"`
 }
 
 // ToObjectDeleteQuery formats a DeleteOpts into a query string.
@@ -316,9 +388,12 @@ type GetOptsBuilder interface {
 }
 
 // GetOpts is a structure that holds parameters for getting an object's metadata.
+// VersionID and Symlink are used as in DownloadOpts.
 type GetOpts struct {
 	Expires   string `q:"expires"`
 	Signature string `q:"signature"`
+	Symlink   string `q:"symlink"`
+	VersionID string `q:"version-id"`
 }
 
 // ToObjectGetQuery formats a GetOpts into a query string.
@@ -349,6 +424,70 @@ func Get(c *gophercloud.ServiceClient, containerName, objectName string, opts Ge
 	return
 }
 
+// SymlinkTarget is the object a symlink links to.
+type SymlinkTarget struct {
+	// Account is the account of the object, when it's not that of the symlink.
+	Account string
+
+	// Container and Object are the names of the container and of the object.
+	Container string
+	Object    string
+
+	// ETag is the ETag of the object, that makes the symlink a static one. It's
+	// only used by CreateSymlink.
+	ETag string
+}
+
+// CreateSymlink creates a symlink to target, with the symlink middleware.
+// Requests made to the symlink are made to the object it links to, unless they
+// set the Symlink option to "get". A static symlink, whose target has an ETag,
+// only resolves as long as the object is unchanged.
+func CreateSymlink(c *gophercloud.ServiceClient, containerName, objectName string, target SymlinkTarget) (r CreateResult) {
+	return Create(c, containerName, objectName, CreateOpts{
+		SymlinkTarget:        escapeSymlinkPath(target.Container + "/" + target.Object),
+		SymlinkTargetAccount: target.Account,
+		SymlinkTargetETag:    target.ETag,
+	})
+}
+
+// ResolveSymlink returns the target of a symlink. It returns an ErrNotSymlink
+// error when the object isn't a symlink, and an ErrInvalidSymlinkTarget error
+// when its target isn't in the "container/object" form. Symlinks to symlinks are resolved
+// one link at a time.
+func ResolveSymlink(c *gophercloud.ServiceClient, containerName, objectName string) (*SymlinkTarget, error) {
+	h, err := Get(c, containerName, objectName, GetOpts{Symlink: "get"}).Extract()
+	if err != nil {
+		return nil, err
+	}
+	if h.SymlinkTarget == "" {
+		return nil, ErrNotSymlink{Container: containerName, Object: objectName}
+	}
+
+	path, err := url.PathUnescape(h.SymlinkTarget)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(path, "/", 2)
+	if len(parts) != 2 {
+		return nil, ErrInvalidSymlinkTarget{Target: h.SymlinkTarget}
+	}
+
+	return &SymlinkTarget{
+		Account:   h.SymlinkTargetAccount,
+		Container: parts[0],
+		Object:    parts[1],
+	}, nil
+}
+
+// escapeSymlinkPath escapes the segments of the path of a symlink target.
+func escapeSymlinkPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return strings.Join(segments, "/")
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -356,7 +495,7 @@ type UpdateOptsBuilder interface {
 }
 
 // UpdateOpts is a structure that holds parameters for updating, creating, or deleting an
-// object's metadata.
+// object's metadata. RemoveDeleteAt cancels the expiry of the object.
 type UpdateOpts struct {
 	Metadata           map[string]string
 	ContentDisposition string `h:"Content-Disposition"`
@@ -364,6 +503,7 @@ type UpdateOpts struct {
 	ContentType        string `h:"Content-Type"`
 	DeleteAfter        int    `h:"X-Delete-After"`
 	DeleteAt           int    `h:"X-Delete-At"`
+	RemoveDeleteAt     bool   `h:"X-Remove-Delete-At"`
 	DetectContentType  bool   `h:"X-Detect-Content-Type"`
 }
 
@@ -412,42 +552,185 @@ var (
 	GET HTTPMethod = "GET"
 	// POST represents an HTTP "POST" method.
 	POST HTTPMethod = "POST"
+	// PUT represents an HTTP "PUT" method.
+	PUT HTTPMethod = "PUT"
+	// HEAD represents an HTTP "HEAD" method.
+	HEAD HTTPMethod = "HEAD"
+	// DELETE represents an HTTP "DELETE" method.
+	DELETE HTTPMethod = "DELETE"
+)
+
+// TempURLDigest is the digest a temporary URL is signed with.
+type TempURLDigest string
+
+const (
+	// SHA1 signs temporary URLs with HMAC-SHA1.
+	SHA1 TempURLDigest = "sha1"
+	// SHA256 signs temporary URLs with HMAC-SHA256.
+	SHA256 TempURLDigest = "sha256"
+	// SHA512 signs temporary URLs with HMAC-SHA512.
+	SHA512 TempURLDigest = "sha512"
 )
 
+var tempURLDigests = map[TempURLDigest]func() hash.Hash{
+	SHA1:   sha1.New,
+	SHA256: sha256.New,
+	SHA512: sha512.New,
+}
+
 // CreateTempURLOpts are options for creating a temporary URL for an object.
 type CreateTempURLOpts struct {
 	// (REQUIRED) Method is the HTTP method to allow for users of the temp URL. Valid values
-	// are "GET" and "POST".
+	// are "GET", "POST", "PUT", "HEAD" and "DELETE".
 	Method HTTPMethod
-	// (REQUIRED) TTL is the number of seconds the temp URL should be active.
+	// (REQUIRED) TTL is the number of seconds the temp URL should be active. It's
+	// ignored when ExpiresAt is set.
 	TTL int
+	// (Optional) ExpiresAt is the time the temp URL expires at, instead of TTL
+	// seconds from now.
+	ExpiresAt time.Time
 	// (Optional) Split is the string on which to split the object URL. Since only
 	// the object path is used in the hash, the object URL needs to be parsed. If
 	// empty, the default OpenStack URL split point will be used ("/v1/").
 	Split string
+	// (Optional) Digest is the digest the temp URL is signed with. It defaults to
+	// SHA1.
+	Digest TempURLDigest
+	// (Optional) TempURLKey is the key the temp URL is signed with, either the
+	// X-Account-Meta-Temp-URL-Key of the account or the
+	// X-Container-Meta-Temp-URL-Key of the container. If empty, the key of the
+	// account is retrieved, at the cost of a request.
+	TempURLKey string
+	// (Optional) Prefix makes the temp URL valid for every object of the
+	// container whose name starts with it, instead of the object alone.
+	Prefix string
+	// (Optional) IPRange restricts the use of the temp URL to the clients whose
+	// address is in it, like "192.0.2.10" or "192.0.2.0/24".
+	IPRange string
+	// (Optional) Inline has the object displayed by browsers, rather than
+	// downloaded.
+	Inline bool
+	// (Optional) Filename is the name browsers save the object under when
+	// downloading it.
+	Filename string
 }
 
 // CreateTempURL is a function for creating a temporary URL for an object. It
 // allows users to have "GET" or "POST" access to a particular tenant's object
 // for a limited amount of time.
+//
+// The other methods, and the digests other than SHA1, are only allowed when the
+// cluster advertises them in its /info document, which is checked unless it's
+// unavailable.
 func CreateTempURL(c *gophercloud.ServiceClient, containerName, objectName string, opts CreateTempURLOpts) (string, error) {
 	if opts.Split == "" {
 		opts.Split = "/v1/"
 	}
-	duration := time.Duration(opts.TTL) * time.Second
-	expiry := time.Now().Add(duration).Unix()
-	getHeader, err := accounts.Get(c, nil).Extract()
+	if opts.Digest == "" {
+		opts.Digest = SHA1
+	}
+	newHash, ok := tempURLDigests[opts.Digest]
+	if !ok {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "Digest"
+		err.Value = opts.Digest
+		return "", err
+	}
+	if opts.Digest != SHA1 || (opts.Method != GET && opts.Method != POST) {
+		if err := checkTempURLCapabilities(c, opts); err != nil {
+			return "", err
+		}
+	}
+
+	expiry := opts.ExpiresAt.Unix()
+	if opts.ExpiresAt.IsZero() {
+		duration := time.Duration(opts.TTL) * time.Second
+		expiry = time.Now().Add(duration).Unix()
+	}
+
+	secretKey := []byte(opts.TempURLKey)
+	if opts.TempURLKey == "" {
+		getHeader, err := accounts.Get(c, nil).Extract()
+		if err != nil {
+			return "", err
+		}
+		secretKey = []byte(getHeader.TempURLKey)
+	}
+
+	baseURL, objectPath, err := splitTempURL(getURL(c, containerName, objectName), opts.Split)
 	if err != nil {
 		return "", err
 	}
-	secretKey := []byte(getHeader.TempURLKey)
-	url := getURL(c, containerName, objectName)
-	splitPath := strings.Split(url, opts.Split)
-	baseURL, objectPath := splitPath[0], splitPath[1]
-	objectPath = opts.Split + objectPath
-	body := fmt.Sprintf("%s\n%d\n%s", opts.Method, expiry, objectPath)
-	hash := hmac.New(sha1.New, secretKey)
+
+	// The signed path is that of the object, or the prefix of the objects the
+	// temp URL is valid for.
+	signedPath := objectPath
+	if opts.Prefix != "" {
+		_, prefixPath, err := splitTempURL(getURL(c, containerName, opts.Prefix), opts.Split)
+		if err != nil {
+			return "", err
+		}
+		signedPath = "prefix:" + prefixPath
+	}
+	body := fmt.Sprintf("%s\n%d\n%s", opts.Method, expiry, signedPath)
+	if opts.IPRange != "" {
+		body = fmt.Sprintf("ip=%s\n%s", opts.IPRange, body)
+	}
+
+	hash := hmac.New(newHash, secretKey)
 	hash.Write([]byte(body))
-	hexsum := fmt.Sprintf("%x", hash.Sum(nil))
-	return fmt.Sprintf("%s%s?temp_url_sig=%s&temp_url_expires=%d", baseURL, objectPath, hexsum, expiry), nil
+	// Swift only reads a hex signature as SHA1 or SHA256, from its length. Other
+	// signatures are prefixed with their digest, and base64 encoded.
+	sig := fmt.Sprintf("%x", hash.Sum(nil))
+	if opts.Digest == SHA512 {
+		sig = "sha512:" + base64.StdEncoding.EncodeToString(hash.Sum(nil))
+	}
+
+	tempURL := fmt.Sprintf("%s%s?temp_url_sig=%s&temp_url_expires=%d", baseURL, objectPath, url.QueryEscape(sig), expiry)
+	if opts.Prefix != "" {
+		tempURL += "&temp_url_prefix=" + url.QueryEscape(opts.Prefix)
+	}
+	if opts.IPRange != "" {
+		tempURL += "&temp_url_ip_range=" + url.QueryEscape(opts.IPRange)
+	}
+	if opts.Filename != "" {
+		tempURL += "&filename=" + url.QueryEscape(opts.Filename)
+	}
+	if opts.Inline {
+		tempURL += "&inline"
+	}
+	return tempURL, nil
+}
+
+// splitTempURL splits the URL of an object into the base URL of the cluster
+// and the path of the object, starting with split.
+func splitTempURL(objectURL, split string) (string, string, error) {
+	splitPath := strings.SplitN(objectURL, split, 2)
+	if len(splitPath) != 2 {
+		err := gophercloud.ErrInvalidInput{}
+		err.Argument = "Split"
+		err.Value = split
+		return "", "", err
+	}
+	return splitPath[0], split + splitPath[1], nil
+}
+
+// checkTempURLCapabilities checks that the cluster allows temporary URLs with
+// the method and the digest of opts. The check is skipped when the /info
+// document of the cluster is unavailable.
+func checkTempURLCapabilities(c *gophercloud.ServiceClient, opts CreateTempURLOpts) error {
+	capabilities, err := info.Get(c).Extract()
+	if err != nil {
+		return nil
+	}
+	if capabilities.TempURL == nil {
+		return ErrTempURLNotSupported{}
+	}
+	if !capabilities.TempURL.AllowsMethod(string(opts.Method)) {
+		return ErrTempURLNotAllowed{Option: "method", Value: string(opts.Method)}
+	}
+	if !capabilities.TempURL.AllowsDigest(string(opts.Digest)) {
+		return ErrTempURLNotAllowed{Option: "digest", Value: string(opts.Digest)}
+	}
+	return nil
 }
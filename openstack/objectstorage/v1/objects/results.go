@@ -30,6 +30,14 @@ type Object struct {
 
 	// Name is the unique name for the object.
 	Name string `json:"name"`
+
+	// SymlinkPath is the path of the object a symlink links to.
+	SymlinkPath string `json:"symlink_path"`
+
+	// VersionID is the ID of the version of the object, and IsLatest tells
+	// whether it's the latest one. They're only set when listing versions.
+	VersionID string `json:"version_id"`
+	IsLatest  bool   `json:"is_latest"`
 }
 
 // ObjectPage is a single page of objects that is returned from a call to the
@@ -58,9 +66,7 @@ func (r ObjectPage) LastMarker() (string, error) {
 
 // ExtractInfo is a function that takes a page of objects and returns their full information.
 func ExtractInfo(r pagination.Page) ([]Object, error) {
-	var s []Object
-	err := (r.(ObjectPage)).ExtractInto(&s)
-	return s, err
+	return pagination.ExtractItems[Object](r, "")
 }
 
 // ExtractNames is a function that takes a page of objects and returns only their names.
@@ -100,25 +106,29 @@ func ExtractNames(r pagination.Page) ([]string, error) {
 
 // DownloadHeader represents the headers returned in the response from a Download request.
 type DownloadHeader struct {
-	AcceptRanges       string                  `json:"Accept-Ranges"`
-	ContentDisposition string                  `json:"Content-Disposition"`
-	ContentEncoding    string                  `json:"Content-Encoding"`
-	ContentLength      int64                   `json:"-"`
-	ContentType        string                  `json:"Content-Type"`
-	Date               gophercloud.JSONRFC1123 `json:"Date"`
-	DeleteAt           gophercloud.JSONUnix    `json:"X-Delete-At"`
-	ETag               string                  `json:"Etag"`
-	LastModified       gophercloud.JSONRFC1123 `json:"Last-Modified"`
-	ObjectManifest     string                  `json:"X-Object-Manifest"`
-	StaticLargeObject  bool                    `json:"X-Static-Large-Object"`
-	TransID            string                  `json:"X-Trans-Id"`
+	AcceptRanges         string                  `json:"Accept-Ranges"`
+	ContentDisposition   string                  `json:"Content-Disposition"`
+	ContentEncoding      string                  `json:"Content-Encoding"`
+	ContentLength        int64                   `json:"-"`
+	ContentType          string                  `json:"Content-Type"`
+	Date                 gophercloud.JSONRFC1123 `json:"Date"`
+	DeleteAt             gophercloud.JSONUnix    `json:"X-Delete-At"`
+	ETag                 string                  `json:"Etag"`
+	LastModified         gophercloud.JSONRFC1123 `json:"Last-Modified"`
+	ObjectManifest       string                  `json:"X-Object-Manifest"`
+	StaticLargeObject    bool                    `json:"X-Static-Large-Object"`
+	SymlinkTarget        string                  `json:"X-Symlink-Target"`
+	SymlinkTargetAccount string                  `json:"X-Symlink-Target-Account"`
+	TransID              string                  `json:"X-Trans-Id"`
+	VersionID            string                  `json:"X-Object-Version-Id"`
 }
 
 func (h *DownloadHeader) UnmarshalJSON(b []byte) error {
 	type tmp DownloadHeader
 	var hTmp *struct {
 		tmp
-		ContentLength string `json:"Content-Length"`
+		ContentLength     string `json:"Content-Length"`
+		StaticLargeObject string `json:"X-Static-Large-Object"`
 	}
 	err := json.Unmarshal(b, &hTmp)
 	if err != nil {
@@ -137,6 +147,13 @@ func (h *DownloadHeader) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if hTmp.StaticLargeObject != "" {
+		h.StaticLargeObject, err = strconv.ParseBool(hTmp.StaticLargeObject)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -174,24 +191,28 @@ func (r *DownloadResult) ExtractContent() ([]byte, error) {
 
 // GetHeader represents the headers returned in the response from a Get request.
 type GetHeader struct {
-	ContentDisposition string                  `json:"Content-Disposition"`
-	ContentEncoding    string                  `json:"Content-Encoding"`
-	ContentLength      int64                   `json:"Content-Length"`
-	ContentType        string                  `json:"Content-Type"`
-	Date               gophercloud.JSONRFC1123 `json:"Date"`
-	DeleteAt           gophercloud.JSONUnix    `json:"X-Delete-At"`
-	ETag               string                  `json:"Etag"`
-	LastModified       gophercloud.JSONRFC1123 `json:"Last-Modified"`
-	ObjectManifest     string                  `json:"X-Object-Manifest"`
-	StaticLargeObject  bool                    `json:"X-Static-Large-Object"`
-	TransID            string                  `json:"X-Trans-Id"`
+	ContentDisposition   string                  `json:"Content-Disposition"`
+	ContentEncoding      string                  `json:"Content-Encoding"`
+	ContentLength        int64                   `json:"Content-Length"`
+	ContentType          string                  `json:"Content-Type"`
+	Date                 gophercloud.JSONRFC1123 `json:"Date"`
+	DeleteAt             gophercloud.JSONUnix    `json:"X-Delete-At"`
+	ETag                 string                  `json:"Etag"`
+	LastModified         gophercloud.JSONRFC1123 `json:"Last-Modified"`
+	ObjectManifest       string                  `json:"X-Object-Manifest"`
+	StaticLargeObject    bool                    `json:"X-Static-Large-Object"`
+	SymlinkTarget        string                  `json:"X-Symlink-Target"`
+	SymlinkTargetAccount string                  `json:"X-Symlink-Target-Account"`
+	TransID              string                  `json:"X-Trans-Id"`
+	VersionID            string                  `json:"X-Object-Version-Id"`
 }
 
 func (h *GetHeader) UnmarshalJSON(b []byte) error {
 	type tmp GetHeader
 	var hTmp *struct {
 		tmp
-		ContentLength string `json:"Content-Length"`
+		ContentLength     string `json:"Content-Length"`
+		StaticLargeObject string `json:"X-Static-Large-Object"`
 	}
 	err := json.Unmarshal(b, &hTmp)
 	if err != nil {
@@ -210,6 +231,13 @@ func (h *GetHeader) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if hTmp.StaticLargeObject != "" {
+		h.StaticLargeObject, err = strconv.ParseBool(hTmp.StaticLargeObject)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -250,6 +278,7 @@ type CreateHeader struct {
 	ETag          string                  `json:"Etag"`
 	LastModified  gophercloud.JSONRFC1123 `json:"Last-Modified"`
 	TransID       string                  `json:"X-Trans-Id"`
+	VersionID     string                  `json:"X-Object-Version-Id"`
 }
 
 func (h *CreateHeader) UnmarshalJSON(b []byte) error {
@@ -4,6 +4,7 @@ import (
 	"crypto/md5"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"testing"
 	"time"
@@ -213,3 +214,159 @@ func HandleGetObjectSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusNoContent)
 	})
 }
+
+// TempURLKey is the temp URL key of the account served by
+// HandleTempURLAccountSuccessfully.
+const TempURLKey = "testKey"
+
+// HandleTempURLAccountSuccessfully creates HTTP handlers at `/v1/AUTH_test/`
+// and `/info` on the test handler mux that respond with the temp URL key of
+// the account, and with the given temp URL capabilities of the cluster.
+func HandleTempURLAccountSuccessfully(t *testing.T, tempURLInfo string) {
+	th.Mux.HandleFunc("/v1/AUTH_test/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Set("X-Account-Meta-Temp-Url-Key", TempURLKey)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	th.Mux.HandleFunc("/info", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"swift": {"version": "2.23.1"}, "tempurl": %s}`, tempURLInfo)
+	})
+}
+
+// ExpectedVersions is the expected result of ListVersions for `testObject` of
+// the container served by HandleListVersionsSuccessfully.
+var ExpectedVersions = []objects.Object{
+	{
+		Bytes:        0,
+		ContentType:  "application/x-deleted;swift_versions_deleted=1",
+		Hash:         "d41d8cd98f00b204e9800998ecf8427e",
+		LastModified: gophercloud.JSONRFC3339MilliNoZ(time.Date(2020, time.March, 2, 10, 0, 0, 0, time.UTC)),
+		Name:         "testObject",
+		VersionID:    "1583143200.00000",
+		IsLatest:     true,
+	},
+	{
+		Bytes:        14,
+		ContentType:  "text/plain",
+		Hash:         "451e372e48e0f6b1114fa0724aa79fa1",
+		LastModified: gophercloud.JSONRFC3339MilliNoZ(time.Date(2020, time.March, 1, 10, 0, 0, 0, time.UTC)),
+		Name:         "testObject",
+		VersionID:    "1583056800.00000",
+	},
+	{
+		Bytes:        12,
+		ContentType:  "text/plain",
+		Hash:         "6f5902ac237024bdd0c176cb93063dc4",
+		LastModified: gophercloud.JSONRFC3339MilliNoZ(time.Date(2020, time.February, 29, 10, 0, 0, 0, time.UTC)),
+		Name:         "testObject",
+		VersionID:    "1582970400.00000",
+	},
+}
+
+// HandleListVersionsSuccessfully creates an HTTP handler at
+// `/versionedContainer` on the test handler mux that responds with the
+// versions of the objects named after `testObject`, two at a time.
+func HandleListVersionsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/versionedContainer", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Accept", "application/json")
+
+		r.ParseForm()
+		th.CheckEquals(t, "true", r.Form.Get("versions"))
+		th.CheckEquals(t, "testObject", r.Form.Get("prefix"))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch marker := r.Form.Get("version_marker"); marker {
+		case "":
+			th.CheckEquals(t, "", r.Form.Get("marker"))
+			fmt.Fprintf(w, `[
+      {
+        "hash": "d41d8cd98f00b204e9800998ecf8427e",
+        "last_modified": "2020-03-02T10:00:00.000000",
+        "bytes": 0,
+        "name": "testObject",
+        "content_type": "application/x-deleted;swift_versions_deleted=1",
+        "version_id": "1583143200.00000",
+        "is_latest": true
+      },
+      {
+        "hash": "451e372e48e0f6b1114fa0724aa79fa1",
+        "last_modified": "2020-03-01T10:00:00.000000",
+        "bytes": 14,
+        "name": "testObject",
+        "content_type": "text/plain",
+        "version_id": "1583056800.00000",
+        "is_latest": false
+      }
+    ]`)
+		case "1583056800.00000":
+			th.CheckEquals(t, "testObject", r.Form.Get("marker"))
+			fmt.Fprintf(w, `[
+      {
+        "hash": "6f5902ac237024bdd0c176cb93063dc4",
+        "last_modified": "2020-02-29T10:00:00.000000",
+        "bytes": 12,
+        "name": "testObject",
+        "content_type": "text/plain",
+        "version_id": "1582970400.00000",
+        "is_latest": false
+      },
+      {
+        "hash": "451e372e48e0f6b1114fa0724aa79fa1",
+        "last_modified": "2020-03-01T10:00:00.000000",
+        "bytes": 14,
+        "name": "testObject.bak",
+        "content_type": "text/plain",
+        "version_id": "1583056800.00000",
+        "is_latest": true
+      }
+    ]`)
+		default:
+			t.Fatalf("Unexpected version marker: [%s]", marker)
+		}
+	})
+}
+
+// HandleSymlinkSuccessfully creates an HTTP handler at `/testContainer/testLink`
+// on the test handler mux that checks the creation of a symlink to
+// `target container/dir/testObject`, and responds to `Get` requests for the
+// symlink itself with its target.
+func HandleSymlinkSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/testContainer/testLink", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		switch r.Method {
+		case "PUT":
+			th.TestHeader(t, r, "X-Symlink-Target", "target%20container/dir/testObject")
+			th.TestHeader(t, r, "X-Symlink-Target-Account", "AUTH_other")
+			b, err := ioutil.ReadAll(r.Body)
+			th.AssertNoErr(t, err)
+			th.CheckEquals(t, 0, len(b))
+			w.WriteHeader(http.StatusCreated)
+		case "HEAD":
+			th.TestFormValues(t, r, map[string]string{"symlink": "get"})
+			w.Header().Set("X-Symlink-Target", "target%20container/dir/testObject")
+			w.Header().Set("X-Symlink-Target-Account", "AUTH_other")
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Fatalf("Unexpected method %s", r.Method)
+		}
+	})
+}
+
+// HandleGetInvalidSymlinkSuccessfully creates an HTTP handler at
+// `/testContainer/testLink` on the test handler mux that responds to `Get`
+// requests for the symlink itself with a target missing its container.
+func HandleGetInvalidSymlinkSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/testContainer/testLink", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "HEAD")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"symlink": "get"})
+		w.Header().Set("X-Symlink-Target", "testObject")
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -2,7 +2,15 @@ package testing
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"hash"
 	"io"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -74,6 +82,16 @@ func TestListObjectInfo(t *testing.T) {
 	th.CheckEquals(t, count, 1)
 }
 
+func TestListAllObjectInfo(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListObjectsInfoSuccessfully(t)
+
+	actual, err := objects.ListAll(fake.ServiceClient(), "testContainer", objects.ListOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedListInfo, actual)
+}
+
 func TestListObjectNames(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -195,3 +213,223 @@ func TestGetObject(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, expected, actual)
 }
+
+// checkTempURL checks that tempURL grants method access to testObject,
+// signed with digest. SHA512 signatures are base64 encoded and prefixed with
+// the digest, since Swift only reads hex signatures as SHA1 or SHA256.
+func checkTempURL(t *testing.T, tempURL string, method string, digest objects.TempURLDigest) {
+	u, err := url.Parse(tempURL)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, th.Server.URL, u.Scheme+"://"+u.Host)
+	th.CheckEquals(t, "/v1/AUTH_test/testContainer/testObject", u.Path)
+
+	newHash := map[objects.TempURLDigest]func() hash.Hash{
+		objects.SHA1:   sha1.New,
+		objects.SHA256: sha256.New,
+		objects.SHA512: sha512.New,
+	}[digest]
+	expires := u.Query().Get("temp_url_expires")
+	mac := hmac.New(newHash, []byte(TempURLKey))
+	fmt.Fprintf(mac, "%s\n%s\n%s", method, expires, u.Path)
+
+	expected := fmt.Sprintf("%x", mac.Sum(nil))
+	if digest == objects.SHA512 {
+		expected = "sha512:" + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	}
+	th.CheckEquals(t, expected, u.Query().Get("temp_url_sig"))
+}
+
+func TestCreateTempURL(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTempURLAccountSuccessfully(t, `{"methods": ["GET", "HEAD", "PUT", "POST", "DELETE"]}`)
+
+	client := fake.ServiceClient()
+	client.Endpoint += "v1/AUTH_test/"
+
+	tempURL, err := objects.CreateTempURL(client, "testContainer", "testObject", objects.CreateTempURLOpts{
+		Method: objects.GET,
+		TTL:    60,
+	})
+	th.AssertNoErr(t, err)
+	checkTempURL(t, tempURL, "GET", objects.SHA1)
+}
+
+func TestCreateTempURLWithDigest(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTempURLAccountSuccessfully(t, `{"methods": ["GET", "HEAD", "PUT", "POST", "DELETE"], "allowed_digests": ["sha256", "sha512"]}`)
+
+	client := fake.ServiceClient()
+	client.Endpoint += "v1/AUTH_test/"
+
+	tempURL, err := objects.CreateTempURL(client, "testContainer", "testObject", objects.CreateTempURLOpts{
+		Method: objects.PUT,
+		TTL:    60,
+		Digest: objects.SHA256,
+	})
+	th.AssertNoErr(t, err)
+	checkTempURL(t, tempURL, "PUT", objects.SHA256)
+
+	tempURL, err = objects.CreateTempURL(client, "testContainer", "testObject", objects.CreateTempURLOpts{
+		Method: objects.DELETE,
+		TTL:    60,
+		Digest: objects.SHA512,
+	})
+	th.AssertNoErr(t, err)
+	checkTempURL(t, tempURL, "DELETE", objects.SHA512)
+}
+
+func TestCreateTempURLNotAllowed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleTempURLAccountSuccessfully(t, `{"methods": ["GET", "POST"], "allowed_digests": ["sha1", "sha256"]}`)
+
+	client := fake.ServiceClient()
+	client.Endpoint += "v1/AUTH_test/"
+
+	_, err := objects.CreateTempURL(client, "testContainer", "testObject", objects.CreateTempURLOpts{
+		Method: objects.PUT,
+		TTL:    60,
+	})
+	th.CheckDeepEquals(t, objects.ErrTempURLNotAllowed{Option: "method", Value: "PUT"}, err)
+
+	_, err = objects.CreateTempURL(client, "testContainer", "testObject", objects.CreateTempURLOpts{
+		Method: objects.GET,
+		TTL:    60,
+		Digest: objects.SHA512,
+	})
+	th.CheckDeepEquals(t, objects.ErrTempURLNotAllowed{Option: "digest", Value: "sha512"}, err)
+
+	_, err = objects.CreateTempURL(client, "testContainer", "testObject", objects.CreateTempURLOpts{
+		Method: objects.GET,
+		TTL:    60,
+		Digest: "md5",
+	})
+	if err == nil {
+		t.Fatalf("Expected an error for an invalid digest")
+	}
+}
+
+func TestCreateTempURLWithKey(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	client := fake.ServiceClient()
+	client.Endpoint += "v1/AUTH_test/"
+
+	// No request is made, since the key is given and the method and digest are
+	// always allowed.
+	expiresAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tempURL, err := objects.CreateTempURL(client, "testContainer", "testObject", objects.CreateTempURLOpts{
+		Method:     objects.GET,
+		ExpiresAt:  expiresAt,
+		TempURLKey: TempURLKey,
+		Inline:     true,
+		Filename:   "report 2029.pdf",
+	})
+	th.AssertNoErr(t, err)
+	checkTempURL(t, tempURL, "GET", objects.SHA1)
+
+	u, err := url.Parse(tempURL)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, fmt.Sprint(expiresAt.Unix()), u.Query().Get("temp_url_expires"))
+	th.CheckEquals(t, "report 2029.pdf", u.Query().Get("filename"))
+	th.CheckEquals(t, true, u.Query().Has("inline"))
+}
+
+func TestCreateTempURLWithPrefixAndIPRange(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	client := fake.ServiceClient()
+	client.Endpoint += "v1/AUTH_test/"
+
+	tempURL, err := objects.CreateTempURL(client, "testContainer", "testObject", objects.CreateTempURLOpts{
+		Method:     objects.GET,
+		TTL:        60,
+		TempURLKey: "containerKey",
+		Prefix:     "test",
+		IPRange:    "192.0.2.0/24",
+	})
+	th.AssertNoErr(t, err)
+
+	u, err := url.Parse(tempURL)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "/v1/AUTH_test/testContainer/testObject", u.Path)
+	th.CheckEquals(t, "test", u.Query().Get("temp_url_prefix"))
+	th.CheckEquals(t, "192.0.2.0/24", u.Query().Get("temp_url_ip_range"))
+
+	mac := hmac.New(sha1.New, []byte("containerKey"))
+	fmt.Fprintf(mac, "ip=192.0.2.0/24\nGET\n%s\nprefix:/v1/AUTH_test/testContainer/test", u.Query().Get("temp_url_expires"))
+	sig := u.Query().Get("temp_url_sig")
+	if strings.HasPrefix(sig, "sha512:") {
+		th.CheckEquals(t, "sha512:"+base64.StdEncoding.EncodeToString(mac.Sum(nil)), sig)
+		return
+	}
+	th.CheckEquals(t, fmt.Sprintf("%x", mac.Sum(nil)), sig)
+}
+
+func TestCreateTempURLInvalidSplit(t *testing.T) {
+	_, err := objects.CreateTempURL(fake.ServiceClient(), "testContainer", "testObject", objects.CreateTempURLOpts{
+		Method:     objects.GET,
+		TTL:        60,
+		TempURLKey: TempURLKey,
+		Split:      "/v2/",
+	})
+	if err == nil {
+		t.Fatalf("Expected an error for a split not in the URL")
+	}
+}
+
+func TestListVersions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListVersionsSuccessfully(t)
+
+	actual, err := objects.ListVersions(fake.ServiceClient(), "versionedContainer", "testObject")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedVersions, actual)
+}
+
+func TestSymlink(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleSymlinkSuccessfully(t)
+
+	target := objects.SymlinkTarget{
+		Account:   "AUTH_other",
+		Container: "target container",
+		Object:    "dir/testObject",
+	}
+	res := objects.CreateSymlink(fake.ServiceClient(), "testContainer", "testLink", target)
+	th.AssertNoErr(t, res.Err)
+
+	actual, err := objects.ResolveSymlink(fake.ServiceClient(), "testContainer", "testLink")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &target, actual)
+}
+
+func TestResolveSymlinkNotSymlink(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetObjectSuccessfully(t)
+
+	_, err := objects.ResolveSymlink(fake.ServiceClient(), "testContainer", "testObject")
+	if _, ok := err.(objects.ErrNotSymlink); !ok {
+		t.Fatalf("Expected an ErrNotSymlink, but got %#v", err)
+	}
+}
+
+func TestResolveSymlinkInvalidTarget(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetInvalidSymlinkSuccessfully(t)
+
+	_, err := objects.ResolveSymlink(fake.ServiceClient(), "testContainer", "testLink")
+	e, ok := err.(objects.ErrInvalidSymlinkTarget)
+	if !ok {
+		t.Fatalf("Expected an ErrInvalidSymlinkTarget, but got %#v", err)
+	}
+	th.CheckEquals(t, "testObject", e.Target)
+}
@@ -64,7 +64,7 @@ func NewObjectStorageV1(pc *gophercloud.ProviderClient, authOpts AuthOpts) (*gop
 		Endpoint:       auth.StorageURL,
 	}
 
-	swiftClient.TokenID = auth.Token
+	swiftClient.SetToken(auth.Token)
 
 	return swiftClient, nil
 }
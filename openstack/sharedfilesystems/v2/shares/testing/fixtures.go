@@ -143,3 +143,20 @@ func MockGetResponse(t *testing.T) {
 		fmt.Fprintf(w, getResponse)
 	})
 }
+
+// MockGetStatusesResponse creates a mock get response returning the given
+// statuses in turn, repeating the last one
+func MockGetStatusesResponse(t *testing.T, statuses ...string) {
+	th.Mux.HandleFunc(shareEndpoint+"/"+shareID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		status := statuses[0]
+		if len(statuses) > 1 {
+			statuses = statuses[1:]
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"share": {"id": "%s", "status": "%s"}}`, shareID, status)
+	})
+}
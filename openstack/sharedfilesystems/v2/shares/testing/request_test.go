@@ -1,10 +1,14 @@
 package testing
 
 import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/sharedfilesystems/v2/shares"
 	th "github.com/gophercloud/gophercloud/testhelper"
 	"github.com/gophercloud/gophercloud/testhelper/client"
-	"testing"
 )
 
 func TestCreate(t *testing.T) {
@@ -42,3 +46,42 @@ func TestGet(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.AssertEquals(t, s.ID, shareID)
 }
+
+func TestWaitForStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetStatusesResponse(t, "creating", "available")
+
+	err := shares.WaitForStatusContext(context.Background(), client.ServiceClient(), shareID, "available", gophercloud.WaitOpts{
+		Timeout:  5 * time.Second,
+		Interval: time.Millisecond,
+	})
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStatusFailsOnError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetStatusesResponse(t, "extending", "extending_error")
+
+	err := shares.WaitForStatusContext(context.Background(), client.ServiceClient(), shareID, "available", gophercloud.WaitOpts{
+		Timeout:  5 * time.Second,
+		Interval: time.Millisecond,
+	})
+	th.AssertEquals(t, gophercloud.ErrUnexpectedStatus{Expected: "available", Actual: "extending_error"}, err)
+}
+
+func TestWaitForStatusTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	MockGetStatusesResponse(t, "creating")
+
+	err := shares.WaitForStatusContext(context.Background(), client.ServiceClient(), shareID, "available", gophercloud.WaitOpts{
+		Timeout:  50 * time.Millisecond,
+		Interval: time.Millisecond,
+	})
+	th.AssertEquals(t, gophercloud.ErrTimeOut{}, err)
+}
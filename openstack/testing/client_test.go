@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
@@ -58,6 +59,7 @@ func TestAuthenticatedClientV3(t *testing.T) {
 	client, err := openstack.AuthenticatedClient(options)
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, ID, client.TokenID)
+	th.CheckEquals(t, time.Date(2013, 2, 2, 18, 30, 59, 0, time.UTC), client.TokenExpiresAt().UTC())
 }
 
 func TestAuthenticatedClientV2(t *testing.T) {
@@ -161,6 +163,59 @@ func TestAuthenticatedClientV2(t *testing.T) {
 	client, err := openstack.AuthenticatedClient(options)
 	th.AssertNoErr(t, err)
 	th.CheckEquals(t, "01234567890", client.TokenID)
+	th.CheckEquals(t, time.Date(2014, 10, 1, 10, 0, 0, 0, time.UTC), client.TokenExpiresAt().UTC())
+}
+
+func TestAuthenticatedClientV3ApplicationCredential(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	issued := 0
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		th.TestJSONRequest(t, r, `
+			{
+				"auth": {
+					"identity": {
+						"methods": ["application_credential"],
+						"application_credential": {
+							"id": "appcred",
+							"secret": "secret"
+						}
+					}
+				}
+			}
+		`)
+
+		issued++
+		w.Header().Add("X-Subject-Token", fmt.Sprintf("token%d", issued))
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{ "token": { "expires_at": "2013-02-02T18:30:59.000000Z" } }`)
+	})
+
+	// The first token is rejected, which requires reauthenticating.
+	th.Mux.HandleFunc("/resource", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") == "token1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		th.TestHeader(t, r, "X-Auth-Token", "token2")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	options := gophercloud.AuthOptions{
+		ApplicationCredentialID:     "appcred",
+		ApplicationCredentialSecret: "secret",
+		AllowReauth:                 true,
+		IdentityEndpoint:            th.Endpoint() + "v3/",
+	}
+	client, err := openstack.AuthenticatedClient(options)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token1", client.Token())
+
+	_, err = client.Request("GET", th.Endpoint()+"resource", &gophercloud.RequestOpts{OkCodes: []int{204}})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "token2", client.Token())
+	th.CheckEquals(t, 2, issued)
 }
 
 func TestIdentityAdminV3Client(t *testing.T) {
@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// SupportedMicroversions is the range of microversions supported by a service,
+// as advertised in its version document.
+type SupportedMicroversions struct {
+	// Min is the oldest microversion the service supports.
+	Min string
+	// Max is the newest microversion the service supports.
+	Max string
+}
+
+// IsSupported reports whether version falls within the supported range.
+func (s SupportedMicroversions) IsSupported(version string) (bool, error) {
+	lower, err := gophercloud.CompareMicroversions(version, s.Min)
+	if err != nil {
+		return false, err
+	}
+	upper, err := gophercloud.CompareMicroversions(version, s.Max)
+	if err != nil {
+		return false, err
+	}
+	return lower >= 0 && upper <= 0, nil
+}
+
+var versionSegment = regexp.MustCompile(`^v\d+(\.\d+)?$`)
+
+// versionEndpoint strips everything following the API version from an endpoint,
+// such as the project ID some services include, to get the URL the version
+// document is served at.
+func versionEndpoint(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, s := range segments {
+		if versionSegment.MatchString(s) {
+			u.Path = "/" + strings.Join(segments[:i+1], "/") + "/"
+			return u.String()
+		}
+	}
+
+	return endpoint
+}
+
+// GetSupportedMicroversions queries the version document of the service the
+// client points to, and returns the range of microversions it supports.
+func GetSupportedMicroversions(client *gophercloud.ServiceClient) (SupportedMicroversions, error) {
+	type valueResp struct {
+		ID         string `json:"id"`
+		Status     string `json:"status"`
+		Version    string `json:"version"`
+		MinVersion string `json:"min_version"`
+	}
+
+	type response struct {
+		Version  *valueResp  `json:"version"`
+		Versions []valueResp `json:"versions"`
+	}
+
+	endpoint := versionEndpoint(client.Endpoint)
+
+	var resp response
+	_, err := client.Request("GET", endpoint, &gophercloud.RequestOpts{
+		JSONResponse: &resp,
+		OkCodes:      []int{200, 300},
+	})
+	if err != nil {
+		return SupportedMicroversions{}, err
+	}
+
+	// A versioned endpoint describes itself, while the root of a service lists
+	// all of its versions, among which the current one is picked.
+	version := resp.Version
+	if version == nil {
+		for i, v := range resp.Versions {
+			if strings.ToLower(v.Status) == "current" {
+				version = &resp.Versions[i]
+				break
+			}
+		}
+	}
+
+	if version == nil || version.Version == "" {
+		return SupportedMicroversions{}, fmt.Errorf("No microversion information available from endpoint %s", endpoint)
+	}
+
+	min := version.MinVersion
+	if min == "" {
+		min = version.Version
+	}
+
+	return SupportedMicroversions{Min: min, Max: version.Version}, nil
+}
+
+// NegotiateMicroversion picks the highest microversion that both the client,
+// which accepts any microversion between min and max, and the service support.
+// It sets the client's Microversion to it, so that the following requests are
+// made with it and resource packages can check for the features it enables
+// with MicroversionAtLeast, and returns it.
+func NegotiateMicroversion(client *gophercloud.ServiceClient, min, max string) (string, error) {
+	supported, err := GetSupportedMicroversions(client)
+	if err != nil {
+		return "", err
+	}
+
+	// The overlap of both ranges is [highest min, lowest max].
+	lower, upper := min, max
+
+	cmp, err := gophercloud.CompareMicroversions(supported.Min, lower)
+	if err != nil {
+		return "", err
+	}
+	if cmp > 0 {
+		lower = supported.Min
+	}
+
+	cmp, err = gophercloud.CompareMicroversions(supported.Max, upper)
+	if err != nil {
+		return "", err
+	}
+	if cmp < 0 {
+		upper = supported.Max
+	}
+
+	cmp, err = gophercloud.CompareMicroversions(lower, upper)
+	if err != nil {
+		return "", err
+	}
+	if cmp > 0 {
+		return "", fmt.Errorf("No microversion between %s and %s is supported by %s, which supports %s to %s",
+			min, max, client.Endpoint, supported.Min, supported.Max)
+	}
+
+	client.Microversion = upper
+	return upper, nil
+}
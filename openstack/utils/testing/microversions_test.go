@@ -0,0 +1,96 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/utils"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func setupVersionDocumentHandler(t *testing.T) {
+	th.Mux.HandleFunc("/v2.1/", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `
+			{
+				"version": {
+					"id": "v2.1",
+					"status": "CURRENT",
+					"version": "2.38",
+					"min_version": "2.1"
+				}
+			}
+		`)
+	})
+}
+
+func TestGetSupportedMicroversions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupVersionDocumentHandler(t)
+
+	c := client.ServiceClient()
+	c.Endpoint = th.Endpoint() + "v2.1/1234/"
+
+	supported, err := utils.GetSupportedMicroversions(c)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, utils.SupportedMicroversions{Min: "2.1", Max: "2.38"}, supported)
+
+	ok, err := supported.IsSupported("2.10")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, true, ok)
+
+	ok, err = supported.IsSupported("2.39")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, false, ok)
+}
+
+func TestGetSupportedMicroversionsFromVersionList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultipleChoices)
+		fmt.Fprintf(w, `
+			{
+				"versions": [
+					{ "id": "v2.0", "status": "DEPRECATED", "version": "", "min_version": "" },
+					{ "id": "v3.0", "status": "CURRENT", "version": "3.27", "min_version": "3.0" }
+				]
+			}
+		`)
+	})
+
+	c := client.ServiceClient()
+
+	supported, err := utils.GetSupportedMicroversions(c)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, utils.SupportedMicroversions{Min: "3.0", Max: "3.27"}, supported)
+}
+
+func TestNegotiateMicroversion(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	setupVersionDocumentHandler(t)
+
+	c := client.ServiceClient()
+	c.Endpoint = th.Endpoint() + "v2.1/"
+
+	v, err := utils.NegotiateMicroversion(c, "2.10", "2.60")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2.38", v)
+	th.CheckEquals(t, "2.38", c.Microversion)
+
+	v, err = utils.NegotiateMicroversion(c, "2.1", "2.20")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "2.20", v)
+
+	_, err = utils.NegotiateMicroversion(c, "2.40", "2.60")
+	if err == nil {
+		t.Fatal("expected an error when the ranges don't overlap")
+	}
+}
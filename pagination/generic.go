@@ -0,0 +1,127 @@
+package pagination
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// rawBodier is implemented by the pages that hold the JSON body they were
+// parsed from, which is the case of the pages fetched by a Pager.
+type rawBodier interface {
+	rawBody() []byte
+}
+
+// ExtractItems returns the items of type T listed under key in the JSON body of
+// page, or making up the whole body when key is empty. The items are decoded
+// straight from the response when the page holds it, instead of re-encoding
+// its parsed body. Resource packages use it to implement their Extract
+// functions:
+//
+//	func ExtractServers(r pagination.Page) ([]Server, error) {
+//		return pagination.ExtractItems[Server](r, "servers")
+//	}
+func ExtractItems[T any](page Page, key string) ([]T, error) {
+	var items []T
+
+	if r, ok := page.(rawBodier); ok && r.rawBody() != nil {
+		raw := r.rawBody()
+		if key != "" {
+			var s map[string]json.RawMessage
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return nil, err
+			}
+			raw = s[key]
+			if raw == nil {
+				return nil, nil
+			}
+		}
+		err := json.Unmarshal(raw, &items)
+		return items, err
+	}
+
+	body := page.GetBody()
+	if key != "" {
+		m, ok := body.(map[string]interface{})
+		if !ok {
+			err := gophercloud.ErrUnexpectedType{}
+			err.Expected = "map[string]interface{}"
+			err.Actual = fmt.Sprintf("%v", reflect.TypeOf(body))
+			return nil, err
+		}
+		body = m[key]
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(b, &items)
+	return items, err
+}
+
+// All returns the items of every page of a Pager, extracted by extract, like
+// servers.ExtractServers. It fetches the next page while extracting the
+// current one. Unlike AllPages, it doesn't merge the pages into a single one
+// to extract it again.
+//
+//	allServers, err := pagination.All(servers.List(client, nil), servers.ExtractServers)
+func All[T any](p Pager, extract func(Page) ([]T, error)) ([]T, error) {
+	it := p.Iterator(IteratorOpts{Prefetch: true})
+	defer it.Close()
+
+	var all []T
+	for it.Next() {
+		items, err := extract(it.Page())
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return all, nil
+}
+
+// Each iterates over each item of each page returned by a Pager, extracted by
+// extract, yielding one at a time to a handler function. Return "false" from
+// the handler to prematurely stop iterating. Only the current page, and the
+// next one when prefetching, are held in memory. The iteration stops with the
+// error of the Pager's context as soon as it's cancelled.
+//
+//	err := pagination.Each(ports.List(client, nil), pagination.IteratorOpts{Prefetch: true}, ports.ExtractPorts,
+//		func(port ports.Port) (bool, error) {
+//			// Handle the port.
+//			return true, nil
+//		})
+func Each[T any](p Pager, opts IteratorOpts, extract func(Page) ([]T, error), handler func(T) (bool, error)) error {
+	it := p.Iterator(opts)
+	defer it.Close()
+
+	for it.Next() {
+		items, err := extract(it.Page())
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			if err := it.pager.client.ContextOrBackground().Err(); err != nil {
+				return err
+			}
+
+			ok, err := handler(item)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+		}
+	}
+
+	return it.Err()
+}
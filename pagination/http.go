@@ -14,12 +14,17 @@ import (
 type PageResult struct {
 	gophercloud.Result
 	url.URL
+
+	// raw is the JSON body Body was parsed from, if any. It lets ExtractItems
+	// decode the items of the page straight from the response.
+	raw []byte
 }
 
 // PageResultFrom parses an HTTP response as JSON and returns a PageResult containing the
 // results, interpreting it as JSON if the content type indicates.
 func PageResultFrom(resp *http.Response) (PageResult, error) {
 	var parsedBody interface{}
+	var raw []byte
 
 	defer resp.Body.Close()
 	rawBody, err := ioutil.ReadAll(resp.Body)
@@ -32,11 +37,14 @@ func PageResultFrom(resp *http.Response) (PageResult, error) {
 		if err != nil {
 			return PageResult{}, err
 		}
+		raw = rawBody
 	} else {
 		parsedBody = rawBody
 	}
 
-	return PageResultFromParsed(resp, parsedBody), err
+	r := PageResultFromParsed(resp, parsedBody)
+	r.raw = raw
+	return r, err
 }
 
 // PageResultFromParsed constructs a PageResult from an HTTP response that has already had its
@@ -51,6 +59,11 @@ func PageResultFromParsed(resp *http.Response, body interface{}) PageResult {
 	}
 }
 
+// rawBody returns the JSON body the page was parsed from, if any.
+func (r PageResult) rawBody() []byte {
+	return r.raw
+}
+
 // Request performs an HTTP request and extracts the http.Response from the result.
 func Request(client *gophercloud.ServiceClient, headers map[string]string, url string) (*http.Response, error) {
 	return client.Get(url, nil, &gophercloud.RequestOpts{
@@ -0,0 +1,150 @@
+package pagination
+
+import (
+	"context"
+)
+
+// IteratorOpts configures the iteration over the pages of a Pager.
+type IteratorOpts struct {
+	// Prefetch fetches the next page in the background while the current one is
+	// processed, so that the latency of the requests overlaps with the work done
+	// on the results. At most two pages are held in memory at once. The URL of
+	// the next page must be known from the current one, which holds for linked
+	// pages and for pages paginated by marker, limit and offset.
+	Prefetch bool
+}
+
+// fetchResult is the outcome of fetching a page in the background.
+type fetchResult struct {
+	page Page
+	err  error
+}
+
+// Iterator walks the pages of a Pager one at a time, holding only the current
+// page, and the next one when prefetching, in memory. Call Next to advance to
+// each page, Page to get it and Err to get the error that stopped the
+// iteration. Call Close when done with an Iterator that may not have reached
+// its end, to cancel any page being prefetched.
+//
+//	it := pager.Iterator(pagination.IteratorOpts{Prefetch: true})
+//	defer it.Close()
+//	for it.Next() {
+//		allPorts, err := ports.ExtractPorts(it.Page())
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator struct {
+	pager    Pager
+	prefetch bool
+	cancel   context.CancelFunc
+
+	page    Page
+	nextURL string
+	nextErr error
+	pending chan fetchResult
+
+	done bool
+	err  error
+}
+
+// Iterator returns an Iterator over the pages of a Pager. Like EachPage, it
+// stops at the first empty page, or at the first page without a next page.
+func (p Pager) Iterator(opts IteratorOpts) *Iterator {
+	it := &Iterator{
+		pager:    p,
+		prefetch: opts.Prefetch,
+		nextURL:  p.initialURL,
+	}
+
+	if p.Err != nil {
+		it.stop(p.Err)
+		return it
+	}
+
+	if opts.Prefetch && p.client != nil {
+		// Bind the requests to a context of our own, so that Close can abandon
+		// the page being prefetched.
+		ctx, cancel := context.WithCancel(p.client.ContextOrBackground())
+		it.pager = p.WithContext(ctx)
+		it.cancel = cancel
+	}
+
+	return it
+}
+
+// Next advances to the next page, which Page then returns. It returns false
+// once there are no more pages, or when an error occurred, which Err returns.
+func (it *Iterator) Next() bool {
+	if it.done {
+		return false
+	}
+	if it.nextErr != nil {
+		return it.stop(it.nextErr)
+	}
+	if it.page != nil && it.nextURL == "" {
+		return it.stop(nil)
+	}
+
+	var page Page
+	var err error
+	if it.pending != nil {
+		r := <-it.pending
+		it.pending = nil
+		page, err = r.page, r.err
+	} else {
+		page, err = it.pager.fetchNextPage(it.nextURL)
+	}
+	if err != nil {
+		return it.stop(err)
+	}
+
+	empty, err := page.IsEmpty()
+	if err != nil {
+		return it.stop(err)
+	}
+	if empty {
+		return it.stop(nil)
+	}
+
+	it.page = page
+	it.nextURL, it.nextErr = page.NextPageURL()
+	if it.prefetch && it.nextErr == nil && it.nextURL != "" {
+		it.pending = make(chan fetchResult, 1)
+		go func(pager Pager, url string, pending chan<- fetchResult) {
+			page, err := pager.fetchNextPage(url)
+			pending <- fetchResult{page: page, err: err}
+		}(it.pager, it.nextURL, it.pending)
+	}
+
+	return true
+}
+
+// Page returns the current page.
+func (it *Iterator) Page() Page {
+	return it.page
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops the iteration, cancelling the page being prefetched if any. It's
+// safe to call several times, and after the iteration has ended.
+func (it *Iterator) Close() {
+	it.stop(it.err)
+}
+
+// stop ends the iteration with err and returns false, for Next to return.
+func (it *Iterator) stop(err error) bool {
+	it.done = true
+	it.err = err
+	it.page = nil
+	it.pending = nil
+	if it.cancel != nil {
+		it.cancel()
+	}
+	return false
+}
@@ -1,10 +1,10 @@
 package pagination
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
-	"reflect"
 	"strings"
 
 	"github.com/gophercloud/gophercloud"
@@ -68,7 +68,21 @@ func (p Pager) WithPageCreator(createPage func(r PageResult) Page) Pager {
 	}
 }
 
+// WithContext returns a new Pager whose page requests are bound to ctx. Iteration
+// stops with the context's error as soon as ctx is cancelled or its deadline
+// expires.
+func (p Pager) WithContext(ctx context.Context) Pager {
+	if p.client != nil {
+		p.client = p.client.WithContext(ctx)
+	}
+	return p
+}
+
 func (p Pager) fetchNextPage(url string) (Page, error) {
+	if err := p.client.ContextOrBackground().Err(); err != nil {
+		return nil, err
+	}
+
 	resp, err := Request(p.client, p.Headers, url)
 	if err != nil {
 		return nil, err
@@ -122,24 +136,22 @@ func (p Pager) EachPage(handler func(Page) (bool, error)) error {
 }
 
 // AllPages returns all the pages from a `List` operation in a single page,
-// allowing the user to retrieve all the pages at once.
+// allowing the user to retrieve all the pages at once. The generic All
+// function returns the items of all the pages without merging them first.
 func (p Pager) AllPages() (Page, error) {
 	// pagesSlice holds all the pages until they get converted into as Page Body.
 	var pagesSlice []interface{}
 	// body will contain the final concatenated Page body.
-	var body reflect.Value
+	var body interface{}
 
 	// Grab a test page to ascertain the page body type.
 	testPage, err := p.fetchNextPage(p.initialURL)
 	if err != nil {
 		return nil, err
 	}
-	// Store the page type so we can use reflection to create a new mega-page of
-	// that type.
-	pageType := reflect.TypeOf(testPage)
 
 	// if it's a single page, just return the testPage (first page)
-	if _, found := pageType.FieldByName("SinglePageBase"); found {
+	if next, err := testPage.NextPageURL(); err == nil && next == "" {
 		return testPage, nil
 	}
 
@@ -171,9 +183,7 @@ func (p Pager) AllPages() (Page, error) {
 		if err != nil {
 			return nil, err
 		}
-		// Set body to value of type `map[string]interface{}`
-		body = reflect.MakeMap(reflect.MapOf(reflect.TypeOf(key), reflect.TypeOf(pagesSlice)))
-		body.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(pagesSlice))
+		body = map[string]interface{}{key: pagesSlice}
 	case []byte:
 		// Iterate over the pages to concatenate the bodies.
 		err = p.EachPage(func(page Page) (bool, error) {
@@ -195,9 +205,7 @@ func (p Pager) AllPages() (Page, error) {
 		for _, slice := range pagesSlice {
 			b = append(b, slice.([]byte)...)
 		}
-		// Set body to value of type `bytes`.
-		body = reflect.New(reflect.TypeOf(b)).Elem()
-		body.SetBytes(b)
+		body = b
 	case []interface{}:
 		// Iterate over the pages to concatenate the bodies.
 		err = p.EachPage(func(page Page) (bool, error) {
@@ -208,34 +216,27 @@ func (p Pager) AllPages() (Page, error) {
 		if err != nil {
 			return nil, err
 		}
-		// Set body to value of type `[]interface{}`
-		body = reflect.MakeSlice(reflect.TypeOf(pagesSlice), len(pagesSlice), len(pagesSlice))
-		for i, s := range pagesSlice {
-			body.Index(i).Set(reflect.ValueOf(s))
-		}
+		body = pagesSlice
 	default:
 		err := gophercloud.ErrUnexpectedType{}
 		err.Expected = "map[string]interface{}/[]byte/[]interface{}"
-		err.Actual = fmt.Sprintf("%v", reflect.TypeOf(testPage.GetBody()))
+		err.Actual = fmt.Sprintf("%T", testPage.GetBody())
 		return nil, err
 	}
 
 	// Each `Extract*` function is expecting a specific type of page coming back,
-	// otherwise the type assertion in those functions will fail. pageType is needed
-	// to create a type in this method that has the same type that the `Extract*`
-	// function is expecting and set the Body of that object to the concatenated
-	// pages.
-	page := reflect.New(pageType)
-	// Set the page body to be the concatenated pages.
-	page.Elem().FieldByName("Body").Set(body)
-	// Set any additional headers that were pass along. The `objectstorage` pacakge,
-	// for example, passes a Content-Type header.
+	// otherwise the type assertion in those functions will fail. The page
+	// creator of the Pager builds a page of that type around the concatenated
+	// pages. Set any additional headers that were pass along. The
+	// `objectstorage` pacakge, for example, passes a Content-Type header.
 	h := make(http.Header)
 	for k, v := range p.Headers {
 		h.Add(k, v)
 	}
-	page.Elem().FieldByName("Header").Set(reflect.ValueOf(h))
-	// Type assert the page to a Page interface so that the type assertion in the
-	// `Extract*` methods will work.
-	return page.Elem().Interface().(Page), err
+	return p.createPage(PageResult{
+		Result: gophercloud.Result{
+			Body:   body,
+			Header: h,
+		},
+	}), nil
 }
@@ -26,6 +26,11 @@ func (current SinglePageBase) IsEmpty() (bool, error) {
 	return true, err
 }
 
+// rawBody returns the JSON body the page was parsed from, if any.
+func (current SinglePageBase) rawBody() []byte {
+	return current.raw
+}
+
 // GetBody returns the single page's body. This method is needed to satisfy the
 // Page interface.
 func (current SinglePageBase) GetBody() interface{} {
@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/gophercloud/gophercloud/testhelper"
+)
+
+func extractLinkedIntsGeneric(r pagination.Page) ([]int, error) {
+	return pagination.ExtractItems[int](r, "ints")
+}
+
+func TestAllLinked(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	actual, err := pagination.All(pager, ExtractLinkedInts)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+}
+
+func TestAllMarker(t *testing.T) {
+	pager := createMarkerPaged(t)
+	defer testhelper.TeardownHTTP()
+
+	actual, err := pagination.All(pager, ExtractMarkerStrings)
+	testhelper.AssertNoErr(t, err)
+	expected := []string{"aaa", "bbb", "ccc", "ddd", "eee", "fff", "ggg", "hhh", "iii"}
+	testhelper.CheckDeepEquals(t, expected, actual)
+}
+
+func TestAllPropagatesPagerError(t *testing.T) {
+	_, err := pagination.All(pagination.Pager{Err: gophercloud.ErrMissingInput{Argument: "id"}}, ExtractLinkedInts)
+	testhelper.CheckEquals(t, gophercloud.ErrMissingInput{Argument: "id"}, err)
+}
+
+func TestEachLinked(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	var actual []int
+	err := pagination.Each(pager, pagination.IteratorOpts{Prefetch: true}, ExtractLinkedInts, func(i int) (bool, error) {
+		actual = append(actual, i)
+		return i < 5, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3, 4, 5}, actual)
+}
+
+func TestEachMarker(t *testing.T) {
+	pager := createMarkerPaged(t)
+	defer testhelper.TeardownHTTP()
+
+	var actual []string
+	err := pagination.Each(pager, pagination.IteratorOpts{Prefetch: true}, ExtractMarkerStrings, func(s string) (bool, error) {
+		actual = append(actual, s)
+		return true, nil
+	})
+	testhelper.AssertNoErr(t, err)
+	expected := []string{"aaa", "bbb", "ccc", "ddd", "eee", "fff", "ggg", "hhh", "iii"}
+	testhelper.CheckDeepEquals(t, expected, actual)
+}
+
+func TestEachWithCancelledContext(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var actual []int
+	err := pagination.Each(pager.WithContext(ctx), pagination.IteratorOpts{}, ExtractLinkedInts, func(i int) (bool, error) {
+		actual = append(actual, i)
+		cancel()
+		return true, nil
+	})
+	testhelper.CheckEquals(t, context.Canceled, err)
+	testhelper.CheckDeepEquals(t, []int{1}, actual)
+}
+
+func TestExtractItems(t *testing.T) {
+	pager := createLinked(t)
+	defer testhelper.TeardownHTTP()
+
+	// Pages fetched by the Pager are decoded from the raw response.
+	actual, err := pagination.All(pager, extractLinkedIntsGeneric)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+
+	// Pages merged by AllPages are decoded from their parsed body.
+	page, err := pager.AllPages()
+	testhelper.AssertNoErr(t, err)
+	actual, err = extractLinkedIntsGeneric(page)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, actual)
+
+	// A missing key has no items.
+	actual, err = pagination.ExtractItems[int](page, "floats")
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckEquals(t, 0, len(actual))
+}
@@ -0,0 +1,98 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+	"github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestIteratorLinked(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		pager := createLinked(t)
+
+		var actual [][]int
+		it := pager.Iterator(pagination.IteratorOpts{Prefetch: prefetch})
+		for it.Next() {
+			ints, err := ExtractLinkedInts(it.Page())
+			testhelper.AssertNoErr(t, err)
+			actual = append(actual, ints)
+		}
+		it.Close()
+		testhelper.AssertNoErr(t, it.Err())
+
+		expected := [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+		testhelper.CheckDeepEquals(t, expected, actual)
+
+		testhelper.TeardownHTTP()
+	}
+}
+
+func TestIteratorPrefetches(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	requested := make(chan struct{})
+	testhelper.Mux.HandleFunc("/page1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [1, 2, 3], "links": { "next": "%s/page2" } }`, testhelper.Server.URL)
+	})
+	testhelper.Mux.HandleFunc("/page2", func(w http.ResponseWriter, r *http.Request) {
+		close(requested)
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [4, 5, 6], "links": { "next": null } }`)
+	})
+
+	pager := pagination.NewPager(createClient(), testhelper.Server.URL+"/page1", func(r pagination.PageResult) pagination.Page {
+		return LinkedPageResult{pagination.LinkedPageBase{PageResult: r}}
+	})
+
+	it := pager.Iterator(pagination.IteratorOpts{Prefetch: true})
+	defer it.Close()
+	testhelper.AssertEquals(t, true, it.Next())
+
+	// The second page is requested while the first one is still being processed.
+	select {
+	case <-requested:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("The second page wasn't prefetched")
+	}
+
+	testhelper.AssertEquals(t, true, it.Next())
+	ints, err := ExtractLinkedInts(it.Page())
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []int{4, 5, 6}, ints)
+	testhelper.AssertEquals(t, false, it.Next())
+	testhelper.AssertNoErr(t, it.Err())
+}
+
+func TestIteratorStopsOnError(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+
+	testhelper.Mux.HandleFunc("/page1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{ "ints": [1, 2, 3], "links": { "next": "%s/page2" } }`, testhelper.Server.URL)
+	})
+	testhelper.Mux.HandleFunc("/page2", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	pager := pagination.NewPager(createClient(), testhelper.Server.URL+"/page1", func(r pagination.PageResult) pagination.Page {
+		return LinkedPageResult{pagination.LinkedPageBase{PageResult: r}}
+	})
+
+	var actual []int
+	err := pagination.Each(pager, pagination.IteratorOpts{Prefetch: true}, ExtractLinkedInts, func(i int) (bool, error) {
+		actual = append(actual, i)
+		return true, nil
+	})
+	if _, ok := err.(gophercloud.ErrDefault500); !ok {
+		t.Errorf("Expected a 500 error, but got %#v", err)
+	}
+	testhelper.CheckDeepEquals(t, []int{1, 2, 3}, actual)
+}
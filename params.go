@@ -116,6 +116,12 @@ func BuildRequestBody(opts interface{}, parent string) (map[string]interface{},
 					continue
 				}
 
+				// Types marshaling themselves, like time.Time, don't necessarily
+				// marshal to a JSON object, and have no fields to check.
+				if _, ok := v.Interface().(json.Marshaler); ok {
+					continue
+				}
+
 				//fmt.Printf("Calling BuildRequestBody with:\n\tv: %+v\n\tf.Name:%s\n", v.Interface(), f.Name)
 				_, err := BuildRequestBody(v.Interface(), f.Name)
 				if err != nil {
@@ -305,8 +311,15 @@ func BuildQueryString(opts interface{}) (*url.URL, error) {
 			if qTag != "" {
 				tags := strings.Split(qTag, ",")
 
-				// if the field is set, add it to the slice of query pieces
-				if !isZero(v) {
+				// if the field is set, add it to the slice of query pieces. A
+				// pointer is set as soon as it isn't nil, so that a *bool can
+				// ask for false.
+				set := !isZero(v)
+				if v.Kind() == reflect.Ptr && !v.IsNil() {
+					set = true
+					v = v.Elem()
+				}
+				if set {
 					switch v.Kind() {
 					case reflect.String:
 						params.Add(tags[0], v.String())
@@ -392,8 +405,15 @@ func BuildHeaders(opts interface{}) (map[string]string, error) {
 			if hTag != "" {
 				tags := strings.Split(hTag, ",")
 
-				// if the field is set, add it to the slice of query pieces
-				if !isZero(v) {
+				// if the field is set, add it to the slice of query pieces. A
+				// pointer is set as soon as it isn't nil, so that a *bool can
+				// ask for false.
+				set := !isZero(v)
+				if v.Kind() == reflect.Ptr && !v.IsNil() {
+					set = true
+					v = v.Elem()
+				}
+				if set {
 					switch v.Kind() {
 					case reflect.String:
 						optsMap[tags[0]] = v.String()
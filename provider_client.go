@@ -2,11 +2,15 @@ package gophercloud
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 )
 
 // DefaultUserAgent is the default User-Agent string set in the request header.
@@ -51,6 +55,8 @@ type ProviderClient struct {
 	IdentityEndpoint string
 
 	// TokenID is the ID of the most recently issued valid token.
+	// NOTE: Aside from within a custom ReauthFunc, this field shouldn't be set by an application.
+	// To safely read or write this value, call `Token` or `SetToken`, respectively
 	TokenID string
 
 	// EndpointLocator describes how this provider discovers the endpoints for
@@ -58,7 +64,13 @@ type ProviderClient struct {
 	EndpointLocator EndpointLocator
 
 	// HTTPClient allows users to interject arbitrary http, https, or other transit behaviors.
-	HTTPClient http.Client
+	// When nil, http.DefaultClient is used. See NewHTTPClient to build a client with custom
+	// TLS, proxy or timeout settings.
+	HTTPClient *http.Client
+
+	// DisableKeepAlives makes the client close the connection after each request, rather
+	// than keeping it open to be reused by the following ones.
+	DisableKeepAlives bool
 
 	// UserAgent represents the User-Agent header in the HTTP request.
 	UserAgent UserAgent
@@ -68,16 +80,205 @@ type ProviderClient struct {
 	// authentication functions for different Identity service versions.
 	ReauthFunc func() error
 
+	// ReauthContextFunc is like ReauthFunc, but receives the context of the
+	// request that triggered the re-authentication so that it can be cancelled
+	// along with it. When set, it is used instead of ReauthFunc.
+	ReauthContextFunc func(ctx context.Context) error
+
+	// RetryPolicy, if set, decides whether a failed request is attempted again,
+	// and how long to wait before doing so. See BackoffRetryPolicy.
+	RetryPolicy RetryPolicy
+
+	// TokenRenewalWindow, if positive, makes the client renew its token this long before it
+	// expires, rather than waiting for a request to be rejected with a 401. That spares
+	// non-idempotent requests from being sent with a token about to expire. It requires a
+	// known token expiry (see SetTokenAndExpiry) and a ReauthFunc.
+	TokenRenewalWindow time.Duration
+
+	// TokenChangedFunc, if set, is called whenever the token is set to a new value, e.g. after
+	// a re-authentication, along with the new expiry (the zero time if it's unknown).
+	TokenChangedFunc func(tokenID string, expiresAt time.Time)
+
+	// Context is the default context for the requests issued by this client
+	// and by the ServiceClients built on top of it. When nil,
+	// context.Background() is used.
+	Context context.Context
+
+	// Debug makes the client log every request and response, with credentials redacted,
+	// to Logger. See also the OS_DEBUG environment variable in the openstack package.
 	Debug bool
+
+	// Logger receives the requests and responses logged in debug mode. When nil, they're
+	// written to the standard error.
+	Logger Logger
+
+	// mut is a mutex for the client. It protects read and write access to client attributes such as getting
+	// and setting the TokenID.
+	mut *sync.RWMutex
+
+	// reauthmut makes concurrent 401s share a single re-authentication.
+	reauthmut *reauthlock
+
+	// tokenExpiresAt is the time the token stops being valid, if known.
+	tokenExpiresAt time.Time
+}
+
+// reauthlock tracks the re-authentication in progress, if any.
+type reauthlock struct {
+	sync.Mutex
+	ongoing *reauthFuture
+}
+
+// reauthFuture holds the outcome of a re-authentication that other requests may wait for.
+type reauthFuture struct {
+	done chan struct{}
+	err  error
+}
+
+// UseTokenLock creates a mutex that is used to allow safe concurrent access to the auth token,
+// and makes concurrent requests that fail with a 401 share a single re-authentication.
+// If the application's ProviderClient is not used concurrently, this doesn't need to be called.
+func (client *ProviderClient) UseTokenLock() {
+	client.mut = new(sync.RWMutex)
+	client.reauthmut = new(reauthlock)
+}
+
+// Token safely reads the value of the auth token from the ProviderClient. Applications should
+// call this method to access the token instead of the TokenID field.
+func (client *ProviderClient) Token() string {
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.TokenID
+}
+
+// SetToken safely sets the value of the auth token in the ProviderClient. Applications may
+// use this method in a custom ReauthFunc. The token expiry is reset to unknown.
+func (client *ProviderClient) SetToken(t string) {
+	client.SetTokenAndExpiry(t, time.Time{})
+}
+
+// SetTokenAndExpiry safely sets the value of the auth token in the ProviderClient along
+// with the time it expires, which is used to renew it ahead of time. See TokenRenewalWindow.
+func (client *ProviderClient) SetTokenAndExpiry(t string, expiresAt time.Time) {
+	if client.mut != nil {
+		client.mut.Lock()
+	}
+	changed := client.TokenID != t || !client.tokenExpiresAt.Equal(expiresAt)
+	client.TokenID = t
+	client.tokenExpiresAt = expiresAt
+	if client.mut != nil {
+		client.mut.Unlock()
+	}
+
+	if changed && client.TokenChangedFunc != nil {
+		client.TokenChangedFunc(t, expiresAt)
+	}
+}
+
+// TokenExpiresAt returns the time the current auth token expires, or the zero time if
+// it isn't known.
+func (client *ProviderClient) TokenExpiresAt() time.Time {
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	return client.tokenExpiresAt
+}
+
+// Copy returns a shallow copy of the client. The token is read under the token lock,
+// so Copy is safe to call while other goroutines re-authenticate the client. If the
+// client uses the token lock, the copy gets locks of its own.
+func (client *ProviderClient) Copy() *ProviderClient {
+	if client.mut != nil {
+		client.mut.RLock()
+		defer client.mut.RUnlock()
+	}
+	c := *client
+	if client.mut != nil {
+		c.UseTokenLock()
+	}
+	return &c
+}
+
+// needsRenewal reports whether the token is due for a proactive renewal.
+func (client *ProviderClient) needsRenewal() bool {
+	if client.TokenRenewalWindow <= 0 || (client.ReauthFunc == nil && client.ReauthContextFunc == nil) {
+		return false
+	}
+	expiresAt := client.TokenExpiresAt()
+	if expiresAt.IsZero() {
+		return false
+	}
+	return time.Now().Add(client.TokenRenewalWindow).After(expiresAt)
+}
+
+// context returns the context requests should be issued with when none has been
+// passed explicitly.
+func (client *ProviderClient) context() context.Context {
+	if client.Context != nil {
+		return client.Context
+	}
+	return context.Background()
+}
+
+// reauth re-authenticates the client, giving up as soon as ctx is done.
+func (client *ProviderClient) reauth(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if client.ReauthContextFunc != nil {
+		return client.ReauthContextFunc(ctx)
+	}
+	return client.ReauthFunc()
+}
+
+// reauthenticate re-authenticates the client after a request that was sent with previousToken
+// failed with a 401. When the token lock is in use, only one re-authentication runs at a time:
+// requests failing while it's in progress wait for its result, and requests whose token has
+// been replaced in the meantime don't trigger another one.
+func (client *ProviderClient) reauthenticate(ctx context.Context, previousToken string) error {
+	if client.reauthmut == nil {
+		return client.reauth(ctx)
+	}
+
+	client.reauthmut.Lock()
+	if f := client.reauthmut.ongoing; f != nil {
+		client.reauthmut.Unlock()
+		select {
+		case <-f.done:
+			return f.err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if client.Token() != previousToken {
+		client.reauthmut.Unlock()
+		return nil
+	}
+	f := &reauthFuture{done: make(chan struct{})}
+	client.reauthmut.ongoing = f
+	client.reauthmut.Unlock()
+
+	f.err = client.reauth(ctx)
+
+	client.reauthmut.Lock()
+	client.reauthmut.ongoing = nil
+	client.reauthmut.Unlock()
+	close(f.done)
+
+	return f.err
 }
 
 // AuthenticatedHeaders returns a map of HTTP headers that are common for all
 // authenticated service requests.
 func (client *ProviderClient) AuthenticatedHeaders() map[string]string {
-	if client.TokenID == "" {
+	t := client.Token()
+	if t == "" {
 		return map[string]string{}
 	}
-	return map[string]string{"X-Auth-Token": client.TokenID}
+	return map[string]string{"X-Auth-Token": t}
 }
 
 // RequestOpts customizes the behavior of the provider.Request() method.
@@ -107,8 +308,97 @@ type RequestOpts struct {
 var applicationJSON = "application/json"
 
 // Request performs an HTTP request using the ProviderClient's current HTTPClient. An authentication
-// header will automatically be provided.
+// header will automatically be provided. The request is bound to the client's Context, if any.
 func (client *ProviderClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	return client.RequestWithContext(client.context(), method, url, options)
+}
+
+// RequestWithContext behaves like Request, but binds the HTTP request, and any re-authentication it
+// triggers, to ctx. Cancelling ctx or exceeding its deadline aborts the request in flight.
+//
+// If the client has a RetryPolicy, failed attempts are retried for as long as the policy allows it.
+func (client *ProviderClient) RequestWithContext(ctx context.Context, method, url string, options *RequestOpts) (*http.Response, error) {
+	resp, reauthenticated, err := client.requestWithRetries(ctx, method, url, options)
+	if err != nil && reauthenticated {
+		e := &ErrErrorAfterReauthentication{}
+		e.ErrOriginal = err
+		return nil, e
+	}
+	return resp, err
+}
+
+// requestWithRetries performs the attempts of a request, and reports whether the client
+// re-authenticated along the way. A request rejected with a 401 is resent once with the new
+// token, within the same retry budget: the retries left apply to the request resent.
+func (client *ProviderClient) requestWithRetries(ctx context.Context, method, url string, options *RequestOpts) (*http.Response, bool, error) {
+	reauthenticated := false
+	for retries := 0; ; {
+		resp, err := client.doRequest(ctx, method, url, options, !reauthenticated)
+		if err == errReauthenticated {
+			reauthenticated = true
+			if options.RawBody != nil {
+				if seeker, ok := options.RawBody.(io.Seeker); ok {
+					seeker.Seek(0, 0)
+				}
+			}
+			continue
+		}
+		if err == nil || client.RetryPolicy == nil || ctx.Err() != nil {
+			return resp, reauthenticated, err
+		}
+
+		delay, retry := client.RetryPolicy.Retry(method, resp, err, retries)
+		if !retry {
+			return resp, reauthenticated, err
+		}
+		retries++
+
+		// The body has to be replayed from the start. A reader that can't be rewound
+		// has already been (partially) consumed, so the request can't be retried.
+		if options.RawBody != nil {
+			seeker, ok := options.RawBody.(io.Seeker)
+			if !ok {
+				return resp, reauthenticated, err
+			}
+			if _, serr := seeker.Seek(0, 0); serr != nil {
+				return resp, reauthenticated, err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, reauthenticated, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// httpClient returns the HTTP client to send requests with, which logs them in debug mode.
+func (client *ProviderClient) httpClient() *http.Client {
+	c := client.HTTPClient
+	if c == nil {
+		c = http.DefaultClient
+	}
+	if !client.Debug {
+		return c
+	}
+
+	logger := client.Logger
+	if logger == nil {
+		logger = defaultLogger
+	}
+	debugClient := *c
+	debugClient.Transport = &LoggingRoundTripper{Rt: c.Transport, Logger: logger}
+	return &debugClient
+}
+
+// errReauthenticated is returned by doRequest when the client re-authenticated after the
+// request was rejected with a 401, and the request is to be sent again.
+var errReauthenticated = errors.New("re-authenticated")
+
+// doRequest performs a single attempt of a request. If canReauth is set, a 401 makes the
+// client re-authenticate, and doRequest return errReauthenticated.
+func (client *ProviderClient) doRequest(ctx context.Context, method, url string, options *RequestOpts, canReauth bool) (*http.Response, error) {
 	var body io.Reader
 	var contentType *string
 
@@ -132,11 +422,18 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 		body = options.RawBody
 	}
 
+	// Renew the token if it's about to expire. Should that fail, the current token is
+	// still used, since it may be valid for a little while longer.
+	if client.needsRenewal() {
+		client.reauthenticate(ctx, client.Token())
+	}
+
 	// Construct the http.Request.
 	req, err := http.NewRequest(method, url, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	// Populate the request headers. Apply options.MoreHeaders last, to give the caller the chance to
 	// modify or omit any header.
@@ -148,6 +445,9 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 	for k, v := range client.AuthenticatedHeaders() {
 		req.Header.Add(k, v)
 	}
+	// Remember the token this request was sent with, to tell whether a 401 calls for a
+	// re-authentication or the token has been renewed meanwhile.
+	prereqtok := req.Header.Get("X-Auth-Token")
 
 	// Set the User-Agent header
 	req.Header.Set("User-Agent", client.UserAgent.Join())
@@ -162,23 +462,26 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 		}
 	}
 
-	// Set connection parameter to close the connection immediately when we've got the response
-	req.Close = true
+	// Set connection parameter to close the connection immediately when we've got the response,
+	// if the connections aren't to be reused.
+	req.Close = client.DisableKeepAlives
 
 	// Issue the request.
-	resp, err := client.HTTPClient.Do(req)
+	resp, err := client.httpClient().Do(req)
 	if err != nil {
 		return nil, err
 	}
 
-	// Allow default OkCodes if none explicitly set
-	if options.OkCodes == nil {
-		options.OkCodes = defaultOkCodes(method)
+	// Allow default OkCodes if none explicitly set. The options may be shared between
+	// concurrent requests, so they're left untouched.
+	okc := options.OkCodes
+	if okc == nil {
+		okc = defaultOkCodes(method)
 	}
 
 	// Validate the HTTP response status.
 	var ok bool
-	for _, code := range options.OkCodes {
+	for _, code := range okc {
 		if resp.StatusCode == code {
 			ok = true
 			break
@@ -194,9 +497,13 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 		respErr := ErrUnexpectedResponseCode{
 			URL:      url,
 			Method:   method,
-			Expected: options.OkCodes,
+			Expected: okc,
 			Actual:   resp.StatusCode,
 			Body:     body,
+
+			ResponseHeader: resp.Header,
+			RequestID:      requestID(resp.Header),
+			Fault:          parseFault(resp.StatusCode, resp.Header, body),
 		}
 		//respErr.Function = "gophercloud.ProviderClient.Request"
 
@@ -208,32 +515,17 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 				err = error400er.Error400(respErr)
 			}
 		case http.StatusUnauthorized:
-			if client.ReauthFunc != nil {
-				err = client.ReauthFunc()
+			if canReauth && (client.ReauthFunc != nil || client.ReauthContextFunc != nil) {
+				err = client.reauthenticate(ctx, prereqtok)
 				if err != nil {
+					if ctxErr := ctx.Err(); ctxErr != nil {
+						return nil, ctxErr
+					}
 					e := &ErrUnableToReauthenticate{}
 					e.ErrOriginal = respErr
 					return nil, e
 				}
-				if options.RawBody != nil {
-					if seeker, ok := options.RawBody.(io.Seeker); ok {
-						seeker.Seek(0, 0)
-					}
-				}
-				resp, err = client.Request(method, url, options)
-				if err != nil {
-					switch err.(type) {
-					case *ErrUnexpectedResponseCode:
-						e := &ErrErrorAfterReauthentication{}
-						e.ErrOriginal = err.(*ErrUnexpectedResponseCode)
-						return nil, e
-					default:
-						e := &ErrErrorAfterReauthentication{}
-						e.ErrOriginal = err
-						return nil, e
-					}
-				}
-				return resp, nil
+				return nil, errReauthenticated
 			}
 			err = ErrDefault401{respErr}
 			if error401er, ok := errType.(Err401er); ok {
@@ -284,6 +576,8 @@ func (client *ProviderClient) Request(method, url string, options *RequestOpts)
 		if err := json.NewDecoder(resp.Body).Decode(options.JSONResponse); err != nil {
 			return nil, err
 		}
+		// Consume anything left after the JSON document, so that the connection can be reused.
+		io.Copy(ioutil.Discard, resp.Body)
 	}
 
 	return resp, nil
@@ -0,0 +1,162 @@
+package gophercloud
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy decides whether a request that failed should be attempted again.
+// It is consulted by ProviderClient.Request after every failed attempt.
+type RetryPolicy interface {
+	// Retry receives the HTTP method of the request, the response of the failed
+	// attempt (nil if none was received), the error it caused and the number of
+	// retries already performed. It returns how long to wait before the next
+	// attempt, and whether there should be one at all.
+	Retry(method string, resp *http.Response, err error, retries int) (time.Duration, bool)
+}
+
+// BackoffRetryPolicy is a RetryPolicy that retries transient failures with an
+// exponentially increasing, randomized delay. A Retry-After header sent by the
+// server takes precedence over the computed delay.
+//
+// Requests with an idempotent method (GET, HEAD, OPTIONS, PUT and DELETE) are
+// retried on any of StatusCodes and on transport errors such as connection
+// resets. Other requests may have been processed by the server when the
+// connection failed, so by default they're only retried when the server
+// explicitly rejected them with one of StatusCodes.
+type BackoffRetryPolicy struct {
+	// MaxRetries is the maximum number of retries performed for a request.
+	MaxRetries int
+
+	// BaseDelay is the delay before the first retry. It doubles on every
+	// following retry. Defaults to 1 second.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between two attempts, including the one requested
+	// by a Retry-After header. When zero, the computed delays are capped at one
+	// minute, and the ones requested by a Retry-After header aren't capped.
+	MaxDelay time.Duration
+
+	// Jitter is the fraction, between 0 and 1, of each delay that is randomized
+	// to keep concurrent clients from retrying in lockstep.
+	Jitter float64
+
+	// StatusCodes lists the HTTP response codes that are considered transient.
+	// Defaults to 429 and 503.
+	StatusCodes []int
+
+	// RetryNonIdempotent allows non-idempotent requests, like POST and PATCH, to
+	// be retried on transport errors as well.
+	RetryNonIdempotent bool
+}
+
+var defaultRetryStatusCodes = []int{429, http.StatusServiceUnavailable}
+
+// defaultMaxRetryDelay caps the computed delays when MaxDelay isn't set.
+const defaultMaxRetryDelay = time.Minute
+
+// Retry implements the RetryPolicy interface.
+func (p BackoffRetryPolicy) Retry(method string, resp *http.Response, err error, retries int) (time.Duration, bool) {
+	if retries >= p.MaxRetries {
+		return 0, false
+	}
+
+	if resp != nil {
+		if !p.isTransientCode(resp.StatusCode) {
+			return 0, false
+		}
+		if delay, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return p.capDelay(delay), true
+		}
+		return p.delay(retries), true
+	}
+
+	if !isTransportError(err) {
+		return 0, false
+	}
+	if !p.RetryNonIdempotent && !isIdempotent(method) {
+		return 0, false
+	}
+	return p.delay(retries), true
+}
+
+func (p BackoffRetryPolicy) isTransientCode(code int) bool {
+	codes := p.StatusCodes
+	if codes == nil {
+		codes = defaultRetryStatusCodes
+	}
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// delay computes the backoff before the given retry.
+func (p BackoffRetryPolicy) delay(retries int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = time.Second
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultMaxRetryDelay
+	}
+	// The delay is capped before being converted, since it soon exceeds the
+	// range of a time.Duration.
+	d := math.Min(float64(base)*math.Pow(2, float64(retries)), float64(maxDelay))
+	if p.Jitter > 0 {
+		j := math.Min(p.Jitter, 1)
+		d = d*(1-j) + d*j*rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+func (p BackoffRetryPolicy) capDelay(d time.Duration) time.Duration {
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return d
+}
+
+// retryAfter parses the value of a Retry-After header, which holds either a
+// number of seconds or an HTTP date.
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(time.Now())
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// isTransportError reports whether err was returned by the HTTP client while
+// sending the request or reading the response, rather than while building it.
+func isTransportError(err error) bool {
+	e, ok := err.(*url.Error)
+	return ok && e.Op != "parse"
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return false
+}
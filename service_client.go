@@ -1,6 +1,7 @@
 package gophercloud
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"strings"
@@ -21,7 +22,54 @@ type ServiceClient struct {
 	// as-is, instead.
 	ResourceBase string
 
+	// Type is the service type of the client, as found in the service catalog
+	// (e.g. "compute" or "volumev2"). It determines the headers Microversion is
+	// sent with.
+	Type string
+
+	// Microversion is the API microversion requested on every call made through
+	// this client. It's sent in the OpenStack-API-Version header, as well as in
+	// the service-specific header older releases of some services expect. See
+	// also utils.NegotiateMicroversion in the openstack package.
 	Microversion string
+
+	// Context, if set, is the context the requests issued through this client are
+	// bound to. It overrides the ProviderClient's Context. Use WithContext to
+	// obtain a copy of a ServiceClient bound to a specific context.
+	Context context.Context
+}
+
+// WithContext returns a shallow copy of the ServiceClient whose requests are
+// bound to ctx. Passing the copy to any resource package function makes that
+// call, including page iteration and re-authentication, honor the
+// cancellation and deadline of ctx:
+//
+//   ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//   defer cancel()
+//   server, err := servers.Get(client.WithContext(ctx), "{serverId}").Extract()
+func (client *ServiceClient) WithContext(ctx context.Context) *ServiceClient {
+	c := *client
+	c.Context = ctx
+	return &c
+}
+
+// Request calls the ProviderClient's RequestWithContext with the context this
+// ServiceClient is bound to.
+func (client *ServiceClient) Request(method, url string, options *RequestOpts) (*http.Response, error) {
+	return client.ProviderClient.RequestWithContext(client.ContextOrBackground(), method, url, options)
+}
+
+// ContextOrBackground returns the context the requests issued through this client
+// are bound to: the client's Context, else the ProviderClient's Context, else
+// context.Background().
+func (client *ServiceClient) ContextOrBackground() context.Context {
+	if client.Context != nil {
+		return client.Context
+	}
+	if client.ProviderClient != nil {
+		return client.ProviderClient.context()
+	}
+	return context.Background()
 }
 
 // ResourceBaseURL returns the base URL of any resources used by this service. It MUST end with a /.
@@ -46,10 +94,7 @@ func (client *ServiceClient) Get(url string, JSONResponse interface{}, opts *Req
 		opts.JSONResponse = JSONResponse
 	}
 
-	if opts.MoreHeaders == nil {
-		opts.MoreHeaders = make(map[string]string)
-	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	client.setMicroversionHeader(opts)
 
 	return client.Request("GET", url, opts)
 }
@@ -70,10 +115,7 @@ func (client *ServiceClient) Post(url string, JSONBody interface{}, JSONResponse
 		opts.JSONResponse = JSONResponse
 	}
 
-	if opts.MoreHeaders == nil {
-		opts.MoreHeaders = make(map[string]string)
-	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	client.setMicroversionHeader(opts)
 
 	return client.Request("POST", url, opts)
 }
@@ -94,10 +136,7 @@ func (client *ServiceClient) Put(url string, JSONBody interface{}, JSONResponse
 		opts.JSONResponse = JSONResponse
 	}
 
-	if opts.MoreHeaders == nil {
-		opts.MoreHeaders = make(map[string]string)
-	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	client.setMicroversionHeader(opts)
 
 	return client.Request("PUT", url, opts)
 }
@@ -118,10 +157,7 @@ func (client *ServiceClient) Patch(url string, JSONBody interface{}, JSONRespons
 		opts.JSONResponse = JSONResponse
 	}
 
-	if opts.MoreHeaders == nil {
-		opts.MoreHeaders = make(map[string]string)
-	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	client.setMicroversionHeader(opts)
 
 	return client.Request("PATCH", url, opts)
 }
@@ -132,10 +168,7 @@ func (client *ServiceClient) Delete(url string, opts *RequestOpts) (*http.Respon
 		opts = &RequestOpts{}
 	}
 
-	if opts.MoreHeaders == nil {
-		opts.MoreHeaders = make(map[string]string)
-	}
-	opts.MoreHeaders["X-OpenStack-Nova-API-Version"] = client.Microversion
+	client.setMicroversionHeader(opts)
 
 	return client.Request("DELETE", url, opts)
 }
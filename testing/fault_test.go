@@ -0,0 +1,163 @@
+package testing
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// requestFault responds to a request with code and body, and returns the error
+// of the request.
+func requestFault(t *testing.T, code int, body string) error {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Openstack-Request-Id", "req-3c1e7a4f")
+		w.WriteHeader(code)
+		fmt.Fprint(w, body)
+	})
+
+	p := &gophercloud.ProviderClient{}
+	_, err := p.Request("GET", th.Endpoint(), &gophercloud.RequestOpts{})
+	if err == nil {
+		t.Fatalf("Expected an error")
+	}
+	return err
+}
+
+func TestFaultFormats(t *testing.T) {
+	cases := []struct {
+		code     int
+		body     string
+		expected gophercloud.Fault
+	}{
+		{
+			// Nova
+			code: 404,
+			body: `{"itemNotFound": {"code": 404, "message": "Instance 9e5476bd could not be found."}}`,
+			expected: gophercloud.Fault{
+				Code:      404,
+				Type:      "itemNotFound",
+				Message:   "Instance 9e5476bd could not be found.",
+				RequestID: "req-3c1e7a4f",
+			},
+		},
+		{
+			// Neutron
+			code: 409,
+			body: `{"NeutronError": {"type": "IpAddressGenerationFailure", "message": "No more IP addresses available on network 0e2a1c6b.", "detail": ""}}`,
+			expected: gophercloud.Fault{
+				Code:      409,
+				Type:      "IpAddressGenerationFailure",
+				Message:   "No more IP addresses available on network 0e2a1c6b.",
+				RequestID: "req-3c1e7a4f",
+			},
+		},
+		{
+			// Heat
+			code: 400,
+			body: `{"explanation": "The server could not comply with the request.", "code": 400, "error": {"message": "Property error: resources.port.properties.network: Error validating value 'foo'", "traceback": null, "type": "StackValidationFailed"}, "title": "Bad Request"}`,
+			expected: gophercloud.Fault{
+				Code:      400,
+				Type:      "StackValidationFailed",
+				Message:   "Property error: resources.port.properties.network: Error validating value 'foo'",
+				RequestID: "req-3c1e7a4f",
+			},
+		},
+		{
+			// Keystone
+			code: 404,
+			body: `{"error": {"code": 404, "title": "Not Found", "message": "Could not find project: 3ab0e5c1."}}`,
+			expected: gophercloud.Fault{
+				Code:      404,
+				Type:      "Not Found",
+				Message:   "Could not find project: 3ab0e5c1.",
+				RequestID: "req-3c1e7a4f",
+			},
+		},
+		{
+			// Octavia
+			code: 409,
+			body: `{"faultcode": "Client", "faultstring": "Load Balancer 5b2e8f1a is immutable and cannot be updated.", "debuginfo": null}`,
+			expected: gophercloud.Fault{
+				Code:      409,
+				Type:      "Client",
+				Message:   "Load Balancer 5b2e8f1a is immutable and cannot be updated.",
+				RequestID: "req-3c1e7a4f",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		err := requestFault(t, c.code, c.body)
+
+		var fault *gophercloud.Fault
+		if !errors.As(err, &fault) {
+			t.Fatalf("Expected a Fault in %#v", err)
+		}
+		th.CheckDeepEquals(t, c.expected, *fault)
+
+		var respErr gophercloud.ErrUnexpectedResponseCode
+		if !errors.As(err, &respErr) {
+			t.Fatalf("Expected an ErrUnexpectedResponseCode in %#v", err)
+		}
+		th.CheckEquals(t, c.code, respErr.Actual)
+		th.CheckEquals(t, "req-3c1e7a4f", respErr.RequestID)
+	}
+}
+
+func TestFaultIs(t *testing.T) {
+	err := requestFault(t, 409, `{"NeutronError": {"type": "IpAddressGenerationFailure", "message": "No more IP addresses available on network 0e2a1c6b.", "detail": ""}}`)
+	th.CheckEquals(t, true, errors.Is(err, &gophercloud.Fault{Type: "IpAddressGenerationFailure"}))
+	th.CheckEquals(t, true, errors.Is(err, &gophercloud.Fault{Code: 409, Type: "IpAddressGenerationFailure"}))
+	th.CheckEquals(t, false, errors.Is(err, &gophercloud.Fault{Code: 400, Type: "IpAddressGenerationFailure"}))
+	th.CheckEquals(t, false, errors.Is(err, &gophercloud.Fault{Type: "OverQuota"}))
+	th.CheckEquals(t, false, errors.Is(err, &gophercloud.Fault{}))
+	th.CheckEquals(t, false, errors.Is(err, gophercloud.ErrQuotaExceeded))
+}
+
+func TestFaultQuotaExceeded(t *testing.T) {
+	bodies := map[int]string{
+		// Nova
+		403: `{"forbidden": {"code": 403, "message": "Quota exceeded for cores: Requested 8, but already used 16 of 20 cores"}}`,
+		// Neutron
+		409: `{"NeutronError": {"type": "OverQuota", "message": "Quota exceeded for resources: ['port'].", "detail": ""}}`,
+		// Cinder
+		413: `{"overLimit": {"code": 413, "message": "VolumeSizeExceedsAvailableQuota: Requested volume or snapshot exceeds allowed gigabytes quota."}}`,
+	}
+
+	for code, body := range bodies {
+		err := requestFault(t, code, body)
+		if !errors.Is(err, gophercloud.ErrQuotaExceeded) {
+			t.Errorf("Expected a quota exceeded error, but got %v", err)
+		}
+	}
+}
+
+func TestFaultInErrorMessage(t *testing.T) {
+	err := requestFault(t, 404, `{"itemNotFound": {"code": 404, "message": "Instance 9e5476bd could not be found."}}`)
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("Expected an ErrDefault404, but got %#v", err)
+	}
+	th.CheckEquals(t, "Resource not found: itemNotFound: Instance 9e5476bd could not be found. (request ID req-3c1e7a4f)", err.Error())
+}
+
+func TestNoFault(t *testing.T) {
+	err := requestFault(t, 500, `<html><body>Internal Server Error</body></html>`)
+
+	var fault *gophercloud.Fault
+	th.CheckEquals(t, false, errors.As(err, &fault))
+
+	var respErr gophercloud.ErrUnexpectedResponseCode
+	if !errors.As(err, &respErr) {
+		t.Fatalf("Expected an ErrUnexpectedResponseCode in %#v", err)
+	}
+	th.CheckEquals(t, "req-3c1e7a4f", respErr.RequestID)
+	th.CheckEquals(t, "Internal Server Error (request ID req-3c1e7a4f)", err.Error())
+}
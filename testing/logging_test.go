@@ -0,0 +1,121 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+type recordingLogger struct {
+	entries []gophercloud.HTTPLogEntry
+}
+
+func (l *recordingLogger) LogHTTP(e gophercloud.HTTPLogEntry) {
+	l.entries = append(l.entries, e)
+}
+
+func TestDebugLogging(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v3/auth/tokens", func(w http.ResponseWriter, r *http.Request) {
+		b, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		// The body still reaches the server unredacted.
+		th.CheckEquals(t, true, strings.Contains(string(b), "s3cr3t"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Subject-Token", "aToken")
+		w.Header().Set("X-Account-Meta-Temp-URL-Key", "aKey")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": {"id": "aToken", "expires_at": "2013-02-02T18:30:59.000000Z"}}`)
+	})
+
+	logger := &recordingLogger{}
+	p := &gophercloud.ProviderClient{
+		TokenID: "anotherToken",
+		Debug:   true,
+		Logger:  logger,
+	}
+
+	var body map[string]interface{}
+	_, err := p.Request("POST", th.Endpoint()+"v3/auth/tokens", &gophercloud.RequestOpts{
+		JSONBody: map[string]interface{}{
+			"auth": map[string]interface{}{
+				"identity": map[string]interface{}{
+					"methods": []string{"password"},
+					"password": map[string]interface{}{
+						"user": map[string]interface{}{
+							"name":     "me",
+							"password": "s3cr3t",
+						},
+					},
+				},
+			},
+		},
+		JSONResponse: &body,
+	})
+	th.AssertNoErr(t, err)
+
+	// The response body still reaches the caller unredacted.
+	th.CheckEquals(t, "aToken", body["token"].(map[string]interface{})["id"])
+
+	th.AssertEquals(t, 2, len(logger.entries))
+
+	req := logger.entries[0]
+	th.CheckEquals(t, false, req.Response)
+	th.CheckEquals(t, "POST", req.Method)
+	th.CheckEquals(t, "***", req.Header.Get("X-Auth-Token"))
+	th.CheckEquals(t, false, strings.Contains(req.Body, "s3cr3t"))
+	th.CheckEquals(t, true, strings.Contains(req.Body, `"name": "me"`))
+
+	resp := logger.entries[1]
+	th.CheckEquals(t, true, resp.Response)
+	th.CheckEquals(t, http.StatusCreated, resp.StatusCode)
+	th.CheckEquals(t, "***", resp.Header.Get("X-Subject-Token"))
+	th.CheckEquals(t, "***", resp.Header.Get("X-Account-Meta-Temp-URL-Key"))
+	th.CheckEquals(t, false, strings.Contains(resp.Body, "aToken"))
+	th.CheckEquals(t, true, strings.Contains(resp.Body, "2013-02-02T18:30:59.000000Z"))
+}
+
+func TestDebugLoggingSkipsNonJSONBodies(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/object", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		fmt.Fprintf(w, "binary data")
+	})
+
+	logger := &recordingLogger{}
+	p := &gophercloud.ProviderClient{Debug: true, Logger: logger}
+
+	resp, err := p.Request("GET", th.Endpoint()+"object", &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	b, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "binary data", string(b))
+
+	th.AssertEquals(t, 2, len(logger.entries))
+	th.CheckEquals(t, "", logger.entries[1].Body)
+}
+
+func TestPrintfLogger(t *testing.T) {
+	var out string
+	l := gophercloud.PrintfLogger{Printf: func(format string, v ...interface{}) {
+		out = fmt.Sprintf(format, v...)
+	}}
+
+	l.LogHTTP(gophercloud.HTTPLogEntry{
+		Method: "GET",
+		URL:    "http://example.com/servers",
+		Header: http.Header{"X-Auth-Token": []string{"***"}},
+	})
+	th.CheckEquals(t, "OpenStack Request: GET http://example.com/servers\nHeaders:\nX-Auth-Token: ***\r\n", out)
+}
@@ -0,0 +1,113 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+	fake "github.com/gophercloud/gophercloud/testhelper/client"
+)
+
+func TestMicroversionHeaders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var header http.Header
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+	})
+
+	c := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       th.Endpoint(),
+	}
+
+	tests := []struct {
+		serviceType string
+		expected    map[string]string
+	}{
+		{"compute", map[string]string{
+			"OpenStack-API-Version":        "compute 2.26",
+			"X-OpenStack-Nova-API-Version": "2.26",
+		}},
+		{"volumev2", map[string]string{
+			"OpenStack-API-Version":          "volume 2.26",
+			"X-OpenStack-Volume-API-Version": "2.26",
+		}},
+		{"sharev2", map[string]string{
+			"OpenStack-API-Version":          "shared-file-system 2.26",
+			"X-OpenStack-Manila-API-Version": "2.26",
+		}},
+		{"network", map[string]string{
+			"OpenStack-API-Version":        "network 2.26",
+			"X-OpenStack-Nova-API-Version": "",
+		}},
+		{"", map[string]string{
+			"OpenStack-API-Version":        "",
+			"X-OpenStack-Nova-API-Version": "2.26",
+		}},
+	}
+
+	for _, test := range tests {
+		c.Type = test.serviceType
+		c.Microversion = "2.26"
+		_, err := c.Get(c.ServiceURL("route"), nil, nil)
+		th.AssertNoErr(t, err)
+		for k, v := range test.expected {
+			th.CheckEquals(t, v, header.Get(k))
+		}
+	}
+
+	c.Microversion = ""
+	_, err := c.Get(c.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, "", header.Get("OpenStack-API-Version"))
+}
+
+func TestCompareMicroversions(t *testing.T) {
+	cmp, err := gophercloud.CompareMicroversions("2.10", "2.9")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, cmp)
+
+	cmp, err = gophercloud.CompareMicroversions("2.1", "3.0")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, -1, cmp)
+
+	cmp, err = gophercloud.CompareMicroversions("2.9", "2.9")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, cmp)
+
+	_, err = gophercloud.CompareMicroversions("2", "2.9")
+	if err == nil {
+		t.Fatal("expected an error for a malformed microversion")
+	}
+}
+
+func TestMicroversionAtLeast(t *testing.T) {
+	c := &gophercloud.ServiceClient{}
+	th.CheckEquals(t, false, c.MicroversionAtLeast("2.1"))
+
+	c.Microversion = "2.6"
+	th.CheckEquals(t, true, c.MicroversionAtLeast("2.1"))
+	th.CheckEquals(t, true, c.MicroversionAtLeast("2.6"))
+	th.CheckEquals(t, false, c.MicroversionAtLeast("2.10"))
+
+	c.Microversion = "latest"
+	th.CheckEquals(t, true, c.MicroversionAtLeast("2.60"))
+}
+
+func TestMicroversionHeaderWithoutServiceType(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.CheckEquals(t, "2.53", r.Header.Get("X-OpenStack-Nova-API-Version"))
+		th.CheckEquals(t, "", r.Header.Get("OpenStack-API-Version"))
+	})
+
+	c := fake.ServiceClient()
+	c.Microversion = "2.53"
+	_, err := c.Get(c.ServiceURL("route"), nil, nil)
+	th.AssertNoErr(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"net/url"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	th "github.com/gophercloud/gophercloud/testhelper"
@@ -80,6 +81,24 @@ func TestBuildQueryString(t *testing.T) {
 	}
 }
 
+func TestBuildQueryStringPointers(t *testing.T) {
+	yes, no := true, false
+	opts := struct {
+		Enabled  *bool   `q:"enabled"`
+		IsDomain *bool   `q:"is_domain"`
+		Name     *string `q:"name"`
+		Unset    *bool   `q:"unset"`
+	}{
+		Enabled:  &yes,
+		IsDomain: &no,
+		Name:     new(string),
+	}
+	expected := &url.URL{RawQuery: "enabled=true&is_domain=false&name="}
+	actual, err := gophercloud.BuildQueryString(&opts)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+}
+
 func TestBuildHeaders(t *testing.T) {
 	testStruct := struct {
 		Accept string `h:"Accept"`
@@ -107,6 +126,20 @@ func TestBuildHeaders(t *testing.T) {
 	}
 }
 
+func TestBuildHeadersPointers(t *testing.T) {
+	no := false
+	opts := struct {
+		VersionsEnabled *bool `h:"X-Versions-Enabled"`
+		Unset           *bool `h:"X-Unset"`
+	}{
+		VersionsEnabled: &no,
+	}
+	expected := map[string]string{"X-Versions-Enabled": "false"}
+	actual, err := gophercloud.BuildHeaders(&opts)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, expected, actual)
+}
+
 func TestQueriesAreEscaped(t *testing.T) {
 	type foo struct {
 		Name  string `q:"something"`
@@ -248,3 +281,20 @@ func TestBuildRequestBody(t *testing.T) {
 		th.AssertDeepEquals(t, reflect.TypeOf(failCase.expected), reflect.TypeOf(err))
 	}
 }
+
+func TestBuildRequestBodyWithTime(t *testing.T) {
+	type opts struct {
+		Name      string     `json:"name"`
+		CreatedAt *time.Time `json:"created_at,omitempty"`
+	}
+
+	createdAt := time.Date(2019, 3, 12, 12, 12, 12, 0, time.UTC)
+	actual, err := gophercloud.BuildRequestBody(opts{Name: "a", CreatedAt: &createdAt}, "thing")
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, map[string]interface{}{
+		"thing": map[string]interface{}{
+			"name":       "a",
+			"created_at": "2019-03-12T12:12:12Z",
+		},
+	}, actual)
+}
@@ -1,10 +1,19 @@
 package testing
 
 import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	th "github.com/gophercloud/gophercloud/testhelper"
+	"github.com/gophercloud/gophercloud/testhelper/client"
 )
 
 func TestAuthenticatedHeaders(t *testing.T) {
@@ -34,3 +43,240 @@ func TestUserAgent(t *testing.T) {
 	actual = p.UserAgent.Join()
 	th.CheckEquals(t, expected, actual)
 }
+
+func TestRequestWithContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "OK")
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &gophercloud.ProviderClient{Context: ctx}
+
+	res, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	_, err = ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	th.AssertNoErr(t, err)
+
+	cancel()
+	res, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	}
+	if !strings.Contains(err.Error(), ctx.Err().Error()) {
+		t.Fatalf("expecting error to contain: %q, got %q", ctx.Err().Error(), err.Error())
+	}
+}
+
+func TestRequestWithContextDeadline(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	p := &gophercloud.ProviderClient{}
+	_, err := p.RequestWithContext(ctx, "GET", ts.URL, &gophercloud.RequestOpts{})
+	if err == nil {
+		t.Fatal("expecting error, got nil")
+	}
+	th.CheckEquals(t, context.DeadlineExceeded, ctx.Err())
+}
+
+func TestReauthWithCancelledContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	reauthCalled := false
+	p := &gophercloud.ProviderClient{}
+	p.ReauthContextFunc = func(ctx context.Context) error {
+		reauthCalled = true
+		cancel()
+		return ctx.Err()
+	}
+
+	_, err := p.RequestWithContext(ctx, "GET", ts.URL, &gophercloud.RequestOpts{})
+	th.CheckEquals(t, context.Canceled, err)
+	th.CheckEquals(t, true, reauthCalled)
+}
+
+func TestConcurrentReauth(t *testing.T) {
+	var info = struct {
+		reauthAttempts int
+		mut            *sync.RWMutex
+	}{
+		0,
+		new(sync.RWMutex),
+	}
+
+	numconc := 20
+
+	prereauthTok := client.TokenID
+	postreauthTok := "12345678"
+
+	p := new(gophercloud.ProviderClient)
+	p.UseTokenLock()
+	p.SetToken(prereauthTok)
+	p.ReauthFunc = func() error {
+		time.Sleep(1 * time.Second)
+		info.mut.Lock()
+		info.reauthAttempts++
+		info.mut.Unlock()
+		p.SetToken(postreauthTok)
+		return nil
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != postreauthTok {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		info.mut.RLock()
+		hasReauthed := info.reauthAttempts != 0
+		info.mut.RUnlock()
+
+		if hasReauthed {
+			th.CheckEquals(t, p.Token(), postreauthTok)
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{}`)
+	})
+
+	wg := new(sync.WaitGroup)
+	reqopts := new(gophercloud.RequestOpts)
+
+	for i := 0; i < numconc; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := p.Request("GET", fmt.Sprintf("%s/route", th.Endpoint()), reqopts)
+			th.CheckNoErr(t, err)
+			if resp == nil {
+				t.Errorf("got a nil response")
+				return
+			}
+			if resp.Body == nil {
+				t.Errorf("response body was nil")
+				return
+			}
+			defer resp.Body.Close()
+			actual, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Errorf("error reading response body: %s", err)
+				return
+			}
+			th.CheckEquals(t, `{}`, string(actual))
+		}()
+	}
+
+	wg.Wait()
+
+	th.AssertEquals(t, 1, info.reauthAttempts)
+}
+
+func TestProactiveTokenRenewal(t *testing.T) {
+	oldTok := client.TokenID
+	newTok := "12345678"
+	newExpiry := time.Now().Add(time.Hour)
+
+	p := new(gophercloud.ProviderClient)
+	p.UseTokenLock()
+	p.SetTokenAndExpiry(oldTok, time.Now().Add(time.Minute))
+	p.TokenRenewalWindow = 5 * time.Minute
+
+	reauthCalls := 0
+	p.ReauthFunc = func() error {
+		reauthCalls++
+		p.SetTokenAndExpiry(newTok, newExpiry)
+		return nil
+	}
+
+	var changes []string
+	p.TokenChangedFunc = func(tokenID string, expiresAt time.Time) {
+		changes = append(changes, tokenID)
+		th.CheckEquals(t, true, expiresAt.Equal(newExpiry))
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", newTok)
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := p.Request("POST", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+
+	// The renewed token isn't in the window anymore, so it's used as-is.
+	_, err = p.Request("POST", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+
+	th.CheckEquals(t, 1, reauthCalls)
+	th.CheckDeepEquals(t, []string{newTok}, changes)
+	th.CheckEquals(t, true, p.TokenExpiresAt().Equal(newExpiry))
+}
+
+func TestNoProactiveTokenRenewalWithoutExpiry(t *testing.T) {
+	p := new(gophercloud.ProviderClient)
+	p.SetToken(client.TokenID)
+	p.TokenRenewalWindow = 5 * time.Minute
+	p.ReauthFunc = func() error {
+		t.Fatal("unexpected reauthentication")
+		return nil
+	}
+
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/route", func(w http.ResponseWriter, r *http.Request) {
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+	})
+
+	_, err := p.Request("GET", th.Endpoint()+"route", &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+}
+
+func TestCopy(t *testing.T) {
+	p := new(gophercloud.ProviderClient)
+	p.UseTokenLock()
+	expiresAt := time.Now().Add(time.Hour)
+	p.SetTokenAndExpiry(client.TokenID, expiresAt)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			p.SetTokenAndExpiry(fmt.Sprintf("token-%d", i), expiresAt)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		p.Copy()
+	}
+	wg.Wait()
+
+	c := p.Copy()
+	th.CheckEquals(t, "token-99", c.Token())
+	th.CheckEquals(t, true, c.TokenExpiresAt().Equal(expiresAt))
+
+	// The copy has its own token.
+	c.SetToken("other")
+	th.CheckEquals(t, "token-99", p.Token())
+}
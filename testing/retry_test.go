@@ -0,0 +1,233 @@
+package testing
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestRetryOnTooManyRequests(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(429)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: gophercloud.BackoffRetryPolicy{MaxRetries: 3, BaseDelay: time.Hour},
+	}
+	_, err := p.Request("GET", th.Endpoint(), &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 3, calls)
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: gophercloud.BackoffRetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond},
+	}
+	_, err := p.Request("GET", th.Endpoint(), &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault503); !ok {
+		t.Fatalf("expected ErrDefault503, got %#v", err)
+	}
+	th.CheckEquals(t, 3, calls)
+}
+
+func TestRetryIgnoresOtherCodes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: gophercloud.BackoffRetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond},
+	}
+	_, err := p.Request("GET", th.Endpoint(), &gophercloud.RequestOpts{})
+	if _, ok := err.(gophercloud.ErrDefault500); !ok {
+		t.Fatalf("expected ErrDefault500, got %#v", err)
+	}
+	th.CheckEquals(t, 1, calls)
+}
+
+func TestRetryRewindsRawBody(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	var bodies []string
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			w.WriteHeader(429)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: gophercloud.BackoffRetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond},
+	}
+	_, err := p.Request("POST", th.Endpoint(), &gophercloud.RequestOpts{
+		RawBody: strings.NewReader("some data"),
+	})
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []string{"some data", "some data"}, bodies)
+}
+
+func TestRetryRequiresSeekableRawBody(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(429)
+	})
+
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: gophercloud.BackoffRetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond},
+	}
+	_, err := p.Request("POST", th.Endpoint(), &gophercloud.RequestOpts{
+		RawBody: ioutil.NopCloser(strings.NewReader("some data")),
+	})
+	if _, ok := err.(gophercloud.ErrDefault429); !ok {
+		t.Fatalf("expected ErrDefault429, got %#v", err)
+	}
+	th.CheckEquals(t, 1, calls)
+}
+
+func TestBackoffRetryPolicy(t *testing.T) {
+	p := gophercloud.BackoffRetryPolicy{
+		MaxRetries: 5,
+		BaseDelay:  time.Second,
+		MaxDelay:   5 * time.Second,
+	}
+
+	resp := &http.Response{StatusCode: 503, Header: http.Header{}}
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+	for i, e := range expected {
+		d, ok := p.Retry("GET", resp, nil, i)
+		th.CheckEquals(t, true, ok)
+		th.CheckEquals(t, e, d)
+	}
+
+	_, ok := p.Retry("GET", resp, nil, 5)
+	th.CheckEquals(t, false, ok)
+
+	resp.Header.Set("Retry-After", "3")
+	d, ok := p.Retry("POST", resp, nil, 0)
+	th.CheckEquals(t, true, ok)
+	th.CheckEquals(t, 3*time.Second, d)
+
+	resp.Header.Set("Retry-After", "60")
+	d, ok = p.Retry("POST", resp, nil, 0)
+	th.CheckEquals(t, true, ok)
+	th.CheckEquals(t, 5*time.Second, d)
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d, _ := p.Retry("GET", &http.Response{StatusCode: 429}, nil, 1)
+		if d < time.Second || d > 2*time.Second {
+			t.Fatalf("delay out of the jitter range: %v", d)
+		}
+	}
+}
+
+func TestBackoffRetryPolicyTransportErrors(t *testing.T) {
+	p := gophercloud.BackoffRetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}
+
+	_, err := http.Get("http://127.0.0.1:0/")
+	if err == nil {
+		t.Fatal("expected a transport error")
+	}
+
+	_, ok := p.Retry("GET", nil, err, 0)
+	th.CheckEquals(t, true, ok)
+
+	_, ok = p.Retry("POST", nil, err, 0)
+	th.CheckEquals(t, false, ok)
+
+	p.RetryNonIdempotent = true
+	_, ok = p.Retry("POST", nil, err, 0)
+	th.CheckEquals(t, true, ok)
+
+	_, ok = p.Retry("GET", nil, gophercloud.ErrMissingInput{}, 0)
+	th.CheckEquals(t, false, ok)
+}
+
+func TestBackoffRetryPolicyDefaultMaxDelay(t *testing.T) {
+	p := gophercloud.BackoffRetryPolicy{MaxRetries: 1000, BaseDelay: time.Second}
+
+	resp := &http.Response{StatusCode: 503, Header: http.Header{}}
+	for _, retries := range []int{10, 100, 999} {
+		d, ok := p.Retry("GET", resp, nil, retries)
+		th.CheckEquals(t, true, ok)
+		th.CheckEquals(t, time.Minute, d)
+	}
+
+	resp.Header.Set("Retry-After", "120")
+	d, ok := p.Retry("GET", resp, nil, 0)
+	th.CheckEquals(t, true, ok)
+	th.CheckEquals(t, 2*time.Minute, d)
+}
+
+func TestRetryAfterReauthUsesSameBudget(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	calls := 0
+	th.Mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 2 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	reauths := 0
+	p := &gophercloud.ProviderClient{
+		RetryPolicy: gophercloud.BackoffRetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond},
+		ReauthFunc: func() error {
+			reauths++
+			return nil
+		},
+	}
+	_, err := p.Request("GET", th.Endpoint(), &gophercloud.RequestOpts{})
+	e, ok := err.(*gophercloud.ErrErrorAfterReauthentication)
+	if !ok {
+		t.Fatalf("expected ErrErrorAfterReauthentication, got %#v", err)
+	}
+	if _, ok := e.ErrOriginal.(gophercloud.ErrDefault503); !ok {
+		t.Fatalf("expected ErrDefault503, got %#v", e.ErrOriginal)
+	}
+	th.CheckEquals(t, 1, reauths)
+	// A 503, the 401, the request resent after re-authentication and the one
+	// retry left.
+	th.CheckEquals(t, 4, calls)
+}
@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"context"
 	"testing"
 
 	"github.com/gophercloud/gophercloud"
@@ -13,3 +14,17 @@ func TestServiceURL(t *testing.T) {
 	actual := c.ServiceURL("more", "parts", "here")
 	th.CheckEquals(t, expected, actual)
 }
+
+type contextKey string
+
+func TestContextOrBackground(t *testing.T) {
+	c := &gophercloud.ServiceClient{ProviderClient: &gophercloud.ProviderClient{}}
+	th.CheckEquals(t, context.Background(), c.ContextOrBackground())
+
+	providerCtx := context.WithValue(context.Background(), contextKey("from"), "provider")
+	c.ProviderClient.Context = providerCtx
+	th.CheckEquals(t, providerCtx, c.ContextOrBackground())
+
+	serviceCtx := context.WithValue(context.Background(), contextKey("from"), "service")
+	th.CheckEquals(t, serviceCtx, c.WithContext(serviceCtx).ContextOrBackground())
+}
@@ -0,0 +1,150 @@
+package testing
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// countingServer returns a server that counts the connections opened to it.
+func countingServer() (*httptest.Server, func() int) {
+	var mut sync.Mutex
+	conns := 0
+
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"ok": true}`+"\n")
+	}))
+	ts.Config.ConnState = func(c net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			mut.Lock()
+			conns++
+			mut.Unlock()
+		}
+	}
+	ts.Start()
+
+	return ts, func() int {
+		mut.Lock()
+		defer mut.Unlock()
+		return conns
+	}
+}
+
+func TestKeepAlive(t *testing.T) {
+	ts, conns := countingServer()
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{}
+	for i := 0; i < 3; i++ {
+		var body map[string]bool
+		_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{JSONResponse: &body})
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, 1, conns())
+}
+
+func TestDisableKeepAlives(t *testing.T) {
+	ts, conns := countingServer()
+	defer ts.Close()
+
+	p := &gophercloud.ProviderClient{DisableKeepAlives: true}
+	for i := 0; i < 3; i++ {
+		var body map[string]bool
+		_, err := p.Request("GET", ts.URL, &gophercloud.RequestOpts{JSONResponse: &body})
+		th.AssertNoErr(t, err)
+	}
+	th.CheckEquals(t, 3, conns())
+}
+
+func TestNewHTTPClientCACert(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	// Without the server's certificate, it can't be verified.
+	c, err := gophercloud.NewHTTPClient(gophercloud.TransportOpts{})
+	th.AssertNoErr(t, err)
+	p := &gophercloud.ProviderClient{HTTPClient: c}
+	_, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	if err == nil {
+		t.Fatal("expected a certificate verification error")
+	}
+
+	dir, err := ioutil.TempDir("", "gophercloud")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	th.AssertNoErr(t, ioutil.WriteFile(caFile, caPEM, 0600))
+
+	c, err = gophercloud.NewHTTPClient(gophercloud.TransportOpts{CACertFile: caFile})
+	th.AssertNoErr(t, err)
+	p.HTTPClient = c
+	_, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+
+	c, err = gophercloud.NewHTTPClient(gophercloud.TransportOpts{Insecure: true})
+	th.AssertNoErr(t, err)
+	p.HTTPClient = c
+	_, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+}
+
+func TestNewHTTPClientInvalidCACert(t *testing.T) {
+	_, err := gophercloud.NewHTTPClient(gophercloud.TransportOpts{CACert: []byte("not a certificate")})
+	if err == nil {
+		t.Fatal("expected an error for an invalid CA certificate")
+	}
+}
+
+func TestNewHTTPClientKeepsTLSConfig(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "gophercloud")
+	th.AssertNoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw})
+	th.AssertNoErr(t, ioutil.WriteFile(caFile, caPEM, 0600))
+
+	// CACert has spare capacity the CA file must not be appended into.
+	caCert := make([]byte, len(caPEM), 2*len(caPEM)+1)
+	copy(caCert, caPEM)
+	spare := caCert[len(caCert):cap(caCert)]
+
+	base := &tls.Config{RootCAs: x509.NewCertPool()}
+	c, err := gophercloud.NewHTTPClient(gophercloud.TransportOpts{
+		TLSConfig:  base,
+		CACert:     caCert,
+		CACertFile: caFile,
+	})
+	th.AssertNoErr(t, err)
+
+	p := &gophercloud.ProviderClient{HTTPClient: c}
+	_, err = p.Request("GET", ts.URL, &gophercloud.RequestOpts{})
+	th.AssertNoErr(t, err)
+
+	if !base.RootCAs.Equal(x509.NewCertPool()) {
+		t.Error("Expected the RootCAs of TLSConfig to be left untouched")
+	}
+	for _, b := range spare {
+		if b != 0 {
+			t.Fatal("Expected the spare capacity of CACert to be left untouched")
+		}
+	}
+}
@@ -1,20 +1,119 @@
 package testing
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud"
 	th "github.com/gophercloud/gophercloud/testhelper"
 )
 
 func TestWaitFor(t *testing.T) {
+	start := time.Now()
 	err := gophercloud.WaitFor(5, func() (bool, error) {
 		return true, nil
 	})
 	th.CheckNoErr(t, err)
+
+	// The first poll happens right away, not after a second.
+	if elapsed := time.Since(start); elapsed >= time.Second {
+		t.Errorf("Expected the first poll to be immediate, took %s", elapsed)
+	}
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	err := gophercloud.WaitFor(0, func() (bool, error) {
+		return false, nil
+	})
+	th.CheckEquals(t, gophercloud.ErrTimeOut{}, err)
+}
+
+func TestWaitForContextBackoff(t *testing.T) {
+	var polls []time.Time
+	err := gophercloud.WaitForContext(context.Background(), gophercloud.WaitOpts{
+		Interval:    10 * time.Millisecond,
+		Multiplier:  2,
+		MaxInterval: 40 * time.Millisecond,
+	}, func(context.Context) (bool, error) {
+		polls = append(polls, time.Now())
+		return len(polls) == 5, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 5, len(polls))
+
+	// The delays are 10, 20, 40 and 40ms.
+	for i, min := range []time.Duration{10, 20, 40, 40} {
+		if delay := polls[i+1].Sub(polls[i]); delay < min*time.Millisecond {
+			t.Errorf("Expected delay %d to be at least %dms, got %s", i, min, delay)
+		}
+	}
+}
+
+func TestWaitForContextTimeout(t *testing.T) {
+	start := time.Now()
+	err := gophercloud.WaitForContext(context.Background(), gophercloud.WaitOpts{
+		Timeout:  50 * time.Millisecond,
+		Interval: 10 * time.Millisecond,
+	}, func(ctx context.Context) (bool, error) {
+		// A request bound to ctx fails when the wait times out.
+		<-ctx.Done()
+		return false, ctx.Err()
+	})
+	th.CheckEquals(t, gophercloud.ErrTimeOut{}, err)
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the wait to time out after 50ms, took %s", elapsed)
+	}
+}
+
+func TestWaitForContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	polls := 0
+	err := gophercloud.WaitForContext(ctx, gophercloud.WaitOpts{
+		Timeout:  time.Minute,
+		Interval: time.Millisecond,
+	}, func(context.Context) (bool, error) {
+		polls++
+		if polls == 2 {
+			cancel()
+		}
+		return false, nil
+	})
+	th.CheckEquals(t, context.Canceled, err)
+	th.CheckEquals(t, 2, polls)
+}
+
+func TestWaitForContextPredicateError(t *testing.T) {
+	expected := gophercloud.ErrUnexpectedStatus{Expected: "ACTIVE", Actual: "ERROR"}
+	err := gophercloud.WaitForContext(context.Background(), gophercloud.WaitOpts{}, func(context.Context) (bool, error) {
+		return false, expected
+	})
+	th.CheckEquals(t, expected, err)
+	th.CheckEquals(t, "Expected status ACTIVE, but the resource entered status ERROR", err.Error())
+}
+
+func TestWaitForStatusContext(t *testing.T) {
+	statuses := []string{"BUILD", "BUILD", "ACTIVE"}
+	get := func(context.Context) (string, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		return status, nil
+	}
+
+	err := gophercloud.WaitForStatusContext(context.Background(), gophercloud.WaitOpts{Interval: time.Millisecond}, get, "ACTIVE", "ERROR")
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 0, len(statuses))
+}
+
+func TestWaitForStatusFailsOnError(t *testing.T) {
+	err := gophercloud.WaitForStatus(5, func() (string, error) {
+		return "error_deleting", nil
+	}, "available", "error", "error_deleting")
+	th.CheckEquals(t, gophercloud.ErrUnexpectedStatus{Expected: "available", Actual: "error_deleting"}, err)
 }
 
 func TestNormalizeURL(t *testing.T) {
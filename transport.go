@@ -0,0 +1,166 @@
+package gophercloud
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TransportOpts configures the HTTP client built by NewHTTPClient.
+type TransportOpts struct {
+	// CACertFile is the path to a PEM bundle of the certificate authorities
+	// trusted to verify the server certificates, in addition to the system
+	// ones.
+	CACertFile string
+
+	// CACert holds PEM-encoded certificate authorities, like CACertFile.
+	CACert []byte
+
+	// ClientCertFile and ClientKeyFile are the paths to the PEM-encoded
+	// certificate and key presented to servers requiring client certificates.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// Insecure disables the verification of server certificates. It should only
+	// be used for testing.
+	Insecure bool
+
+	// TLSConfig is the base TLS configuration the above settings are applied
+	// to. It is cloned, never modified.
+	TLSConfig *tls.Config
+
+	// ProxyURL is the URL of the proxy requests are sent through. When empty,
+	// the proxy is taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	// environment variables.
+	ProxyURL string
+
+	// Timeout limits the time a request may take as a whole, including reading
+	// the response body. Zero means no limit.
+	Timeout time.Duration
+
+	// DialTimeout limits the time establishing a connection may take.
+	// Defaults to 30 seconds.
+	DialTimeout time.Duration
+
+	// TLSHandshakeTimeout limits the time the TLS handshake may take.
+	// Defaults to 10 seconds.
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout limits the time to wait for the response headers
+	// once the request has been sent. Zero means no limit.
+	ResponseHeaderTimeout time.Duration
+
+	// IdleConnTimeout is how long an idle connection is kept open for reuse.
+	// Defaults to 90 seconds.
+	IdleConnTimeout time.Duration
+
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept open
+	// for reuse to each host. Defaults to 16.
+	MaxIdleConnsPerHost int
+}
+
+// NewHTTPClient builds an HTTP client according to opts, suitable to be set as
+// a ProviderClient's HTTPClient.
+func NewHTTPClient(opts TransportOpts) (*http.Client, error) {
+	tlsConfig, err := opts.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := http.ProxyFromEnvironment
+	if opts.ProxyURL != "" {
+		u, err := url.Parse(opts.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		proxy = http.ProxyURL(u)
+	}
+
+	transport := &http.Transport{
+		Proxy: proxy,
+		DialContext: (&net.Dialer{
+			Timeout:   durationOrDefault(opts.DialTimeout, 30*time.Second),
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   durationOrDefault(opts.TLSHandshakeTimeout, 10*time.Second),
+		ResponseHeaderTimeout: opts.ResponseHeaderTimeout,
+		IdleConnTimeout:       durationOrDefault(opts.IdleConnTimeout, 90*time.Second),
+		MaxIdleConnsPerHost:   16,
+		ExpectContinueTimeout: time.Second,
+	}
+	if opts.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = opts.MaxIdleConnsPerHost
+	}
+
+	return &http.Client{
+		Transport: transport,
+		Timeout:   opts.Timeout,
+	}, nil
+}
+
+func (opts TransportOpts) tlsConfig() (*tls.Config, error) {
+	config := &tls.Config{}
+	if opts.TLSConfig != nil {
+		config = opts.TLSConfig.Clone()
+	}
+
+	if opts.Insecure {
+		config.InsecureSkipVerify = true
+	}
+
+	caCert := opts.CACert
+	if opts.CACertFile != "" {
+		b, err := ioutil.ReadFile(opts.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		// Build a new slice, as appending to CACert could write into its
+		// spare capacity.
+		bundle := make([]byte, 0, len(caCert)+1+len(b))
+		bundle = append(bundle, caCert...)
+		bundle = append(bundle, '\n')
+		caCert = append(bundle, b...)
+	}
+	if len(caCert) > 0 {
+		// Clone is shallow, so copy the pool rather than adding to the one of
+		// TLSConfig.
+		var pool *x509.CertPool
+		if config.RootCAs != nil {
+			pool = config.RootCAs.Clone()
+		} else {
+			pool, _ = x509.SystemCertPool()
+			if pool == nil {
+				pool = x509.NewCertPool()
+			}
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("No valid PEM certificate found in the CA certificates")
+		}
+		config.RootCAs = pool
+	}
+
+	if opts.ClientCertFile != "" || opts.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		certs := make([]tls.Certificate, 0, len(config.Certificates)+1)
+		certs = append(certs, config.Certificates...)
+		config.Certificates = append(certs, cert)
+	}
+
+	return config, nil
+}
+
+func durationOrDefault(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
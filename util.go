@@ -1,7 +1,7 @@
 package gophercloud
 
 import (
-	"errors"
+	"context"
 	"net/url"
 	"path/filepath"
 	"strings"
@@ -12,28 +12,154 @@ import (
 // It usually does this to wait for a resource to transition to a certain state.
 // Resource packages will wrap this in a more convenient function that's
 // specific to a certain resource, but it can also be useful on its own.
+//
+// timeout is in seconds, and a negative value means no timeout. Use
+// WaitForContext for cancellation and configurable polling.
+//
+// WaitFor is built on WaitForContext, which changes two of its behaviors
+// compared to earlier releases: the predicate is first polled right away
+// rather than after a second, and on timeout WaitFor returns an ErrTimeOut
+// rather than an untyped "A timeout occurred" error.
 func WaitFor(timeout int, predicate func() (bool, error)) error {
-	start := time.Now().Second()
-	for {
-		// Force a 1s sleep
-		time.Sleep(1 * time.Second)
+	opts := WaitOpts{
+		Interval: 1 * time.Second,
+	}
+	if timeout >= 0 {
+		opts.Timeout = time.Duration(timeout) * time.Second
+		if opts.Timeout == 0 {
+			// Zero means no timeout to WaitOpts, but an immediate one here.
+			opts.Timeout = time.Nanosecond
+		}
+	}
+
+	return WaitForContext(context.Background(), opts, func(context.Context) (bool, error) {
+		return predicate()
+	})
+}
+
+// WaitOpts configures the polling done by WaitForContext.
+type WaitOpts struct {
+	// Timeout bounds the time spent waiting, including the time spent in the
+	// predicate. Zero means no timeout other than the context's.
+	Timeout time.Duration
+
+	// Interval is the delay between the first polls. Defaults to 1 second.
+	Interval time.Duration
+
+	// Multiplier is the factor the delay is multiplied by after each poll, for
+	// an exponential backoff. Values up to 1 keep the delay constant.
+	Multiplier float64
 
-		// If a timeout is set, and that's been exceeded, shut it down
-		if timeout >= 0 && time.Now().Second()-start >= timeout {
-			return errors.New("A timeout occurred")
+	// MaxInterval caps the delay between two polls. Zero means no cap.
+	MaxInterval time.Duration
+}
+
+// WaitForContext polls a predicate function until it's satisfied, returns an
+// error, the timeout of opts expires or ctx is done. The first poll happens
+// right away. The predicate receives a context that expires along with the
+// wait, to bind its requests to, for example through
+// ServiceClient.WithContext.
+//
+// WaitForContext returns the predicate's error as is, which lets predicates
+// fail fast when a resource enters a failed state, ErrTimeOut when the timeout
+// of opts expires, and the error of ctx when it's done.
+func WaitForContext(ctx context.Context, opts WaitOpts, predicate func(ctx context.Context) (bool, error)) error {
+	parent := ctx
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = 1 * time.Second
+	}
+
+	// timeoutErr tells apart the expiry of the timeout from the end of parent.
+	timeoutErr := func() error {
+		if err := parent.Err(); err != nil {
+			return err
 		}
+		return ErrTimeOut{}
+	}
 
-		// Execute the function
-		satisfied, err := predicate()
+	for {
+		if ctx.Err() != nil {
+			return timeoutErr()
+		}
+
+		satisfied, err := predicate(ctx)
 		if err != nil {
+			if ctx.Err() != nil {
+				// The predicate most likely failed because the wait ended.
+				return timeoutErr()
+			}
 			return err
 		}
 		if satisfied {
 			return nil
 		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return timeoutErr()
+		case <-timer.C:
+		}
+
+		if opts.Multiplier > 1 {
+			interval = time.Duration(float64(interval) * opts.Multiplier)
+		}
+		if opts.MaxInterval > 0 && interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
 	}
 }
 
+// WaitForStatus polls get, once per second, until it returns status, for at
+// most timeout seconds as WaitFor does. Resource packages wrap it with a getter
+// returning the status of the resource.
+//
+// It fails fast with an ErrUnexpectedStatus when get returns one of
+// errorStatuses, the statuses the resource doesn't leave by itself.
+func WaitForStatus(timeout int, get func() (string, error), status string, errorStatuses ...string) error {
+	return WaitFor(timeout, func() (bool, error) {
+		return checkStatus(get, status, errorStatuses)
+	})
+}
+
+// WaitForStatusContext behaves like WaitForStatus, but polls as configured by
+// opts until ctx is done, as WaitForContext does. get receives the context of
+// the wait, to bind its requests to.
+func WaitForStatusContext(ctx context.Context, opts WaitOpts, get func(ctx context.Context) (string, error), status string, errorStatuses ...string) error {
+	return WaitForContext(ctx, opts, func(ctx context.Context) (bool, error) {
+		return checkStatus(func() (string, error) { return get(ctx) }, status, errorStatuses)
+	})
+}
+
+// checkStatus tells whether get returns status, failing if it returns one of
+// errorStatuses instead.
+func checkStatus(get func() (string, error), status string, errorStatuses []string) (bool, error) {
+	current, err := get()
+	if err != nil {
+		return false, err
+	}
+
+	if current == status {
+		return true, nil
+	}
+
+	for _, s := range errorStatuses {
+		if current == s {
+			return false, ErrUnexpectedStatus{Expected: status, Actual: current}
+		}
+	}
+
+	return false, nil
+}
+
 // NormalizeURL is an internal function to be used by provider clients.
 //
 // It ensures that each endpoint URL has a closing `/`, as expected by